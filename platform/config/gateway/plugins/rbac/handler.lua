@@ -20,6 +20,7 @@ local PUBLIC_ROUTES = {
 local ROUTES = {
     -- Inventory: writes Admin only; stock-out open to all authenticated (no entry needed)
     { path = "/api/inventory/active-products", methods = {"POST","PUT","DELETE"}, roles = {"Admin"} },
+    { path = "/api/inventory/catalog",                                            roles = {"Admin"} },
 
     -- Identity: user management Admin only
     { path = "/api/identity/users",                                               roles = {"Admin"} },
@@ -10,6 +10,7 @@ import (
 	"log"
 	"net"
 	"os"
+	"time"
 
 	"inventory-service/internal/api/grpc"
 	"inventory-service/internal/api/rest"
@@ -17,6 +18,8 @@ import (
 	"inventory-service/internal/database"
 	"inventory-service/internal/repository"
 	"inventory-service/internal/service"
+	"inventory-service/internal/storage"
+	"inventory-service/internal/worker"
 	inventoryv1 "inventory-service/proto/inventory/v1"
 
 	"github.com/gin-gonic/gin"
@@ -26,16 +29,21 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
-func startRESTServer(svc service.InventoryService, port string) {
+func startRESTServer(svc service.InventoryService, categories service.CategoryService, pricing service.PricingService, promotions service.PromotionService, media service.MediaService, catalog service.CatalogService, mediaDir string, port string) {
 	// Set Gin to ReleaseMode to hide the debug output
 	gin.SetMode(gin.ReleaseMode)
 
 	r := gin.New() // Use gin.New() + Recovery to keep logs clean
 	r.Use(gin.Recovery())
 
-	handler := rest.NewInventoryHandler(svc)
+	handler := rest.NewInventoryHandler(svc, categories, pricing, promotions, media, catalog)
 	handler.SetupRoutes(r)
 
+	// Serve images stored on the local filesystem; S3 serves its own
+	if mediaDir != "" {
+		r.Static("/media", mediaDir)
+	}
+
 	if err := r.Run(fmt.Sprintf(":%s", port)); err != nil {
 		log.Fatalf("failed to run REST server: %v", err)
 	}
@@ -49,6 +57,55 @@ func requireEnv(key string) string {
 	return value
 }
 
+// envString reads an optional setting, falling back when unset.
+func envString(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+// initMediaStorage stores product images in S3 when S3_ENDPOINT is set and in a local
+// directory otherwise, which it returns so the REST server can serve it.
+func initMediaStorage() (storage.Storage, string) {
+	endpoint := envString("S3_ENDPOINT", "")
+	if endpoint == "" {
+		dir := envString("MEDIA_DIR", "media")
+		return storage.NewLocalStorage(dir, envString("MEDIA_PUBLIC_URL", "/media")), dir
+	}
+
+	s3, err := storage.NewS3Storage(storage.S3Config{
+		Endpoint:  endpoint,
+		Region:    envString("S3_REGION", "us-east-1"),
+		Bucket:    envString("MEDIA_BUCKET", "product-media"),
+		AccessKey: requireEnv("S3_ACCESS_KEY"),
+		SecretKey: requireEnv("S3_SECRET_KEY"),
+		PublicURL: envString("MEDIA_PUBLIC_URL", ""),
+	})
+	if err != nil {
+		log.Fatalf("CRITICAL: Invalid object storage settings: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := s3.EnsureBucket(ctx); err != nil {
+		log.Fatalf("failed to prepare media bucket: %v", err)
+	}
+	return s3, ""
+}
+
+// envDuration reads an optional duration such as "15m", falling back when unset.
+func envDuration(key string, fallback time.Duration) time.Duration {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("CRITICAL: Invalid duration for %s: %v", key, err)
+	}
+	return d
+}
+
 func main() {
 	// 0. Load .env file for local development
 	if err := godotenv.Load(); err != nil {
@@ -56,13 +113,13 @@ func main() {
 	}
 
 	// 1. Initialize Tracer
-	tp, err := config.InitTracer()
+	shutdownTelemetry, err := config.InitTracer()
 	if err != nil {
 		log.Fatalf("failed to initialize tracer: %v", err)
 	}
 	defer func() {
-		if err := tp.Shutdown(context.Background()); err != nil {
-			log.Printf("Error shutting down tracer provider: %v", err)
+		if err := shutdownTelemetry(context.Background()); err != nil {
+			log.Printf("Error shutting down telemetry providers: %v", err)
 		}
 	}()
 
@@ -74,16 +131,44 @@ func main() {
 	dbPort := requireEnv("DB_PORT")
 	grpcPort := requireEnv("GRPC_PORT")
 	restPort := requireEnv("REST_PORT")
+	reservationTTL := envDuration("RESERVATION_TTL", service.DefaultReservationTTL)
+	sweepInterval := envDuration("RESERVATION_SWEEP_INTERVAL", time.Minute)
+	retention := envDuration("RESERVATION_RETENTION", service.DefaultRetention)
+	purgeInterval := envDuration("RESERVATION_PURGE_INTERVAL", time.Hour)
+	importInterval := envDuration("CATALOG_IMPORT_POLL_INTERVAL", 10*time.Second)
 
 	// 3. Init DB
 	db := database.InitDB(dbHost, dbUser, dbPassword, dbName, dbPort)
 
 	// 4. Setup Layers
 	repo := repository.NewPostgresRepository(db)
-	svc := service.NewInventoryService(repo)
+	mediaStorage, mediaDir := initMediaStorage()
+	svc := service.NewInventoryService(repo,
+		service.WithReservationTTL(reservationTTL),
+		service.WithRetention(retention),
+		service.WithImageStorage(mediaStorage),
+	)
+	categories := service.NewCategoryService(repository.NewPostgresCategoryRepository(db))
+	pricing := service.NewPricingService(repository.NewPostgresPricingRepository(db))
+	promotions := service.NewPromotionService(repository.NewPostgresPromotionRepository(db))
+	media := service.NewMediaService(repository.NewPostgresMediaRepository(db), mediaStorage)
+	catalog := service.NewCatalogService(repository.NewPostgresCatalogRepository(db))
 
 	// 5. Start REST Server (in goroutine)
-	go startRESTServer(svc, restPort)
+	go startRESTServer(svc, categories, pricing, promotions, media, catalog, mediaDir, restPort)
+
+	// Release reservations abandoned by a crashed saga
+	go worker.NewReservationSweeper(svc, sweepInterval).Run(context.Background())
+
+	// Drop settled reservations and idempotency records past the retention window
+	purger, err := worker.NewRetentionPurger(svc, purgeInterval)
+	if err != nil {
+		log.Fatalf("failed to create retention purger: %v", err)
+	}
+	go purger.Run(context.Background())
+
+	// Run uploaded catalog files queued for import
+	go worker.NewCatalogImporter(catalog, importInterval).Run(context.Background())
 
 	// Log Configured Endpoints (Go style)
 	log.Printf("Configured Endpoint: HttpApi -> http://0.0.0.0:%s (Http1)", restPort)
@@ -100,7 +185,7 @@ func main() {
 		googlegrpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
 	
-	inventoryHandler := grpc.NewInventoryHandler(svc)
+	inventoryHandler := grpc.NewInventoryHandler(svc, categories, pricing, promotions, catalog)
 	inventoryv1.RegisterInventoryServiceServer(s, inventoryHandler)
 
 	// Enable reflection for easy testing with grpcurl
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"inventory-service/internal/models"
+	inventoryv1 "inventory-service/proto/inventory/v1"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var filterOperators = map[inventoryv1.FilterOperator]models.RuleOperator{
+	inventoryv1.FilterOperator_FILTER_OPERATOR_UNSPECIFIED: models.OpEqual,
+	inventoryv1.FilterOperator_FILTER_OPERATOR_EQ:          models.OpEqual,
+	inventoryv1.FilterOperator_FILTER_OPERATOR_NE:          models.OpNotEqual,
+	inventoryv1.FilterOperator_FILTER_OPERATOR_LT:          models.OpLessThan,
+	inventoryv1.FilterOperator_FILTER_OPERATOR_LTE:         models.OpLessOrEqual,
+	inventoryv1.FilterOperator_FILTER_OPERATOR_GT:          models.OpGreaterThan,
+	inventoryv1.FilterOperator_FILTER_OPERATOR_GTE:         models.OpGreaterOrEqual,
+}
+
+func toAttributeFilters(filters []*inventoryv1.AttributeFilter) []models.AttributeFilter {
+	var converted []models.AttributeFilter
+	for _, filter := range filters {
+		operator, ok := filterOperators[filter.Operator]
+		if !ok {
+			operator = models.RuleOperator(filter.Operator.String()) // Rejected by the repository
+		}
+		converted = append(converted, models.AttributeFilter{Key: filter.Key, Operator: operator, Value: filter.Value})
+	}
+	return converted
+}
+
+// toProtoAttributes converts attribute values, which always come from JSON and so always fit
+// a Struct.
+func toProtoAttributes(values models.AttributeValues) *structpb.Struct {
+	if len(values) == 0 {
+		return nil
+	}
+	attributes, err := structpb.NewStruct(values)
+	if err != nil {
+		return nil
+	}
+	return attributes
+}
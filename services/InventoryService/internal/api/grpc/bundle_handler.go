@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	inventoryv1 "inventory-service/proto/inventory/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *InventoryHandler) SetBundleComponents(ctx context.Context, req *inventoryv1.SetBundleComponentsRequest) (*inventoryv1.SetBundleComponentsResponse, error) {
+	components := make([]models.BundleComponent, 0, len(req.Components))
+	for _, component := range req.Components {
+		components = append(components, models.BundleComponent{ComponentID: component.ProductId, Quantity: component.Quantity})
+	}
+	bundle, err := s.service.SetBundleComponents(ctx, req.ProductId, components)
+	if err != nil {
+		return nil, toBundleStatusError(err)
+	}
+	return &inventoryv1.SetBundleComponentsResponse{Bundle: toProtoProduct(bundle)}, nil
+}
+
+func toProtoComponents(components []models.BundleComponent) []*inventoryv1.BundleComponent {
+	var protos []*inventoryv1.BundleComponent
+	for _, component := range components {
+		protos = append(protos, &inventoryv1.BundleComponent{ProductId: component.ComponentID, Quantity: component.Quantity})
+	}
+	return protos
+}
+
+func toBundleStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidBundle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrBundleHasStock),
+		errors.Is(err, repository.ErrNestedBundle),
+		errors.Is(err, repository.ErrProductHasVariants),
+		errors.Is(err, repository.ErrProductArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
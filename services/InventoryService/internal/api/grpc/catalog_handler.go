@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/service"
+	inventoryv1 "inventory-service/proto/inventory/v1"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpsertProducts applies a stream of products in chunks of service.ImportBatchSize, each in
+// one transaction, and answers with a summary once the client closes the stream. A product
+// that fails only skips itself. An error aborts the sync, but the chunks already applied
+// stay; since upserts can be repeated, the client may simply run the sync again.
+func (s *InventoryHandler) UpsertProducts(stream inventoryv1.InventoryService_UpsertProductsServer) error {
+	ctx := stream.Context()
+	summary := &inventoryv1.UpsertProductsResponse{}
+	var chunk []models.CatalogRecord
+	index := 0
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		results, err := s.catalog.UpsertProducts(ctx, chunk, false)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return status.Error(codes.Internal, err.Error())
+		}
+		for _, result := range results {
+			switch result.Outcome {
+			case models.UpsertCreated:
+				summary.Created++
+			case models.UpsertUpdated:
+				summary.Updated++
+			default:
+				summary.Failed++
+				if len(summary.Failures) < service.MaxReportedFailures {
+					summary.Failures = append(summary.Failures, &inventoryv1.UpsertFailure{
+						Index:     int32(index),
+						ProductId: result.ProductID,
+						Reason:    result.Message,
+					})
+				}
+			}
+			index++
+		}
+		chunk = chunk[:0]
+		return nil
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		chunk = append(chunk, toCatalogRecord(req))
+		if len(chunk) == service.ImportBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	return stream.SendAndClose(summary)
+}
+
+func toCatalogRecord(req *inventoryv1.UpsertProductRequest) models.CatalogRecord {
+	record := models.CatalogRecord{
+		ProductID:   req.ProductId,
+		Name:        req.Name,
+		Description: req.Description,
+		Quantity:    req.Quantity,
+	}
+	if req.GetParentId() != "" {
+		record.ParentID = req.ParentId
+	}
+	if req.UnitPrice != nil {
+		record.UnitPrice = &models.Money{MinorUnits: req.UnitPrice.MinorUnits, Currency: req.UnitPrice.CurrencyCode}
+	}
+	if len(req.Options) > 0 {
+		record.Options = req.Options
+	}
+	if req.Categories != nil {
+		record.Categories = append([]string{}, req.Categories.CategoryIds...)
+	}
+	if req.Attributes != nil {
+		record.Attributes = req.Attributes.AsMap()
+	}
+	return record
+}
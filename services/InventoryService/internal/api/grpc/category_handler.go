@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	inventoryv1 "inventory-service/proto/inventory/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *InventoryHandler) CreateCategory(ctx context.Context, req *inventoryv1.CreateCategoryRequest) (*inventoryv1.CreateCategoryResponse, error) {
+	category, err := s.categories.CreateCategory(ctx, req.CategoryId, req.Name, req.ParentId)
+	if err != nil {
+		return nil, toCategoryStatusError(err)
+	}
+	return &inventoryv1.CreateCategoryResponse{Category: toProtoCategory(category)}, nil
+}
+
+func (s *InventoryHandler) UpdateCategory(ctx context.Context, req *inventoryv1.UpdateCategoryRequest) (*inventoryv1.UpdateCategoryResponse, error) {
+	category, err := s.categories.UpdateCategory(ctx, req.CategoryId, req.Name, req.ParentId)
+	if err != nil {
+		return nil, toCategoryStatusError(err)
+	}
+	return &inventoryv1.UpdateCategoryResponse{Category: toProtoCategory(category)}, nil
+}
+
+func (s *InventoryHandler) DeleteCategory(ctx context.Context, req *inventoryv1.DeleteCategoryRequest) (*inventoryv1.DeleteCategoryResponse, error) {
+	if err := s.categories.DeleteCategory(ctx, req.CategoryId); err != nil {
+		return nil, toCategoryStatusError(err)
+	}
+	return &inventoryv1.DeleteCategoryResponse{Success: true, Message: "Category deleted successfully"}, nil
+}
+
+func (s *InventoryHandler) GetCategory(ctx context.Context, req *inventoryv1.GetCategoryRequest) (*inventoryv1.GetCategoryResponse, error) {
+	category, err := s.categories.GetCategory(ctx, req.CategoryId)
+	if err != nil {
+		return nil, toCategoryStatusError(err)
+	}
+	return &inventoryv1.GetCategoryResponse{Category: toProtoCategory(category)}, nil
+}
+
+func (s *InventoryHandler) ListCategories(ctx context.Context, req *inventoryv1.ListCategoriesRequest) (*inventoryv1.ListCategoriesResponse, error) {
+	categories, err := s.categories.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv1.ListCategoriesResponse{Categories: toProtoCategories(categories)}, nil
+}
+
+func (s *InventoryHandler) SetProductCategories(ctx context.Context, req *inventoryv1.SetProductCategoriesRequest) (*inventoryv1.SetProductCategoriesResponse, error) {
+	categories, err := s.categories.SetProductCategories(ctx, req.ProductId, req.CategoryIds)
+	if err != nil {
+		return nil, toCategoryStatusError(err)
+	}
+	return &inventoryv1.SetProductCategoriesResponse{Categories: toProtoCategories(categories)}, nil
+}
+
+func toCategoryStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCategory):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrCategoryNotFound), errors.Is(err, repository.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrCategoryExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrCategoryHasChildren), errors.Is(err, repository.ErrCategoryCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+func toProtoCategory(category models.Category) *inventoryv1.Category {
+	protoCategory := &inventoryv1.Category{CategoryId: category.ID, Name: category.Name}
+	if category.ParentID != nil {
+		protoCategory.ParentId = *category.ParentID
+	}
+	return protoCategory
+}
+
+func toProtoCategories(categories []models.Category) []*inventoryv1.Category {
+	var protoCategories []*inventoryv1.Category
+	for _, category := range categories {
+		protoCategories = append(protoCategories, toProtoCategory(category))
+	}
+	return protoCategories
+}
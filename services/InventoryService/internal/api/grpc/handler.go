@@ -2,23 +2,41 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
 	"inventory-service/internal/service"
 	inventoryv1 "inventory-service/proto/inventory/v1"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type InventoryHandler struct {
 	inventoryv1.UnimplementedInventoryServiceServer
-	service service.InventoryService
+	service    service.InventoryService
+	categories service.CategoryService
+	pricing    service.PricingService
+	promotions service.PromotionService
+	catalog    service.CatalogService
 }
 
-func NewInventoryHandler(svc service.InventoryService) *InventoryHandler {
-	return &InventoryHandler{service: svc}
+func NewInventoryHandler(svc service.InventoryService, categories service.CategoryService, pricing service.PricingService, promotions service.PromotionService, catalog service.CatalogService) *InventoryHandler {
+	return &InventoryHandler{service: svc, categories: categories, pricing: pricing, promotions: promotions, catalog: catalog}
 }
 
 func (s *InventoryHandler) ReserveStock(ctx context.Context, req *inventoryv1.ReserveStockRequest) (*inventoryv1.ReserveStockResponse, error) {
-	success, msg, err := s.service.Reserve(ctx, req.OrderId, req.ProductId, req.Quantity)
+	success, msg, err := s.service.Reserve(ctx, req.OrderId, req.ProductId, req.Quantity, time.Duration(req.TtlSeconds)*time.Second)
 	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrIdempotencyConflict):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, repository.ErrInvalidQuantity):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	return &inventoryv1.ReserveStockResponse{Success: success, Message: msg}, nil
@@ -41,11 +59,17 @@ func (s *InventoryHandler) BatchReserveStock(ctx context.Context, req *inventory
 		})
 	}
 
-	success, msg, err := s.service.BatchReserve(ctx, req.OrderId, items)
+	success, msg, results, err := s.service.BatchReserve(ctx, req.OrderId, items, time.Duration(req.TtlSeconds)*time.Second, req.AllowPartial)
 	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrIdempotencyConflict):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, repository.ErrInvalidQuantity):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
-	return &inventoryv1.BatchReserveStockResponse{Success: success, Message: msg}, nil
+	return &inventoryv1.BatchReserveStockResponse{Success: success, Message: msg, Items: toProtoItemResults(results)}, nil
 }
 
 func (s *InventoryHandler) BatchReleaseStock(ctx context.Context, req *inventoryv1.BatchReleaseStockRequest) (*inventoryv1.BatchReleaseStockResponse, error) {
@@ -57,11 +81,61 @@ func (s *InventoryHandler) BatchReleaseStock(ctx context.Context, req *inventory
 		})
 	}
 
-	success, msg, err := s.service.BatchRelease(ctx, req.OrderId, items)
+	success, msg, results, err := s.service.BatchRelease(ctx, req.OrderId, items)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv1.BatchReleaseStockResponse{Success: success, Message: msg, Items: toProtoItemResults(results)}, nil
+}
+
+func (s *InventoryHandler) ConfirmReservation(ctx context.Context, req *inventoryv1.ConfirmReservationRequest) (*inventoryv1.ConfirmReservationResponse, error) {
+	success, msg, err := s.service.ConfirmReservation(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv1.ConfirmReservationResponse{Success: success, Message: msg}, nil
+}
+
+func (s *InventoryHandler) CancelReservation(ctx context.Context, req *inventoryv1.CancelReservationRequest) (*inventoryv1.CancelReservationResponse, error) {
+	success, msg, err := s.service.CancelReservation(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv1.CancelReservationResponse{Success: success, Message: msg}, nil
+}
+
+func (s *InventoryHandler) GetReservation(ctx context.Context, req *inventoryv1.GetReservationRequest) (*inventoryv1.GetReservationResponse, error) {
+	summary, err := s.service.GetReservation(ctx, req.OrderId)
 	if err != nil {
+		if errors.Is(err, repository.ErrReservationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, err
 	}
-	return &inventoryv1.BatchReleaseStockResponse{Success: success, Message: msg}, nil
+
+	var lines []*inventoryv1.ReservationLine
+	for _, line := range summary.Lines {
+		lines = append(lines, &inventoryv1.ReservationLine{
+			ProductId:   line.ProductID,
+			Quantity:    line.Quantity,
+			Status:      toProtoReservationStatus(line.Status),
+			CreatedAt:   timestamppb.New(line.CreatedAt),
+			UpdatedAt:   timestamppb.New(line.UpdatedAt),
+			ExpiresAt:   timestamppb.New(line.ExpiresAt),
+			ConfirmedAt: optionalTimestamp(line.ConfirmedAt),
+			ReleasedAt:  optionalTimestamp(line.ReleasedAt),
+			UnitPrice:   toProtoMoney(line.UnitPrice),
+		})
+	}
+
+	return &inventoryv1.GetReservationResponse{
+		OrderId:   summary.OrderID,
+		Status:    toProtoReservationStatus(summary.Status),
+		Lines:     lines,
+		CreatedAt: timestamppb.New(summary.CreatedAt),
+		UpdatedAt: timestamppb.New(summary.UpdatedAt),
+		ExpiresAt: timestamppb.New(summary.ExpiresAt),
+	}, nil
 }
 
 func (s *InventoryHandler) GetStock(ctx context.Context, req *inventoryv1.GetStockRequest) (*inventoryv1.GetStockResponse, error) {
@@ -73,38 +147,114 @@ func (s *InventoryHandler) GetStock(ctx context.Context, req *inventoryv1.GetSto
 }
 
 func (s *InventoryHandler) ListProducts(ctx context.Context, req *inventoryv1.ListProductsRequest) (*inventoryv1.ListProductsResponse, error) {
-	products, err := s.service.ListProducts(ctx)
+	query := models.ProductQuery{
+		PageSize:       int(req.PageSize),
+		PageToken:      req.PageToken,
+		SortBy:         toProductSort(req.SortBy),
+		Descending:     req.Descending,
+		MinPrice:       toMinorUnits(req.MinPriceMinor, req.MinPrice),
+		MaxPrice:       toMinorUnits(req.MaxPriceMinor, req.MaxPrice),
+		InStockOnly:    req.InStockOnly,
+		OutOfStockOnly: req.OutOfStockOnly,
+		CategoryID:     req.CategoryId,
+		GroupVariants:  req.GroupVariants,
+		Attributes:     toAttributeFilters(req.AttributeFilters),
+	}
+	page, err := s.service.ListProducts(ctx, query)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidPageToken) || errors.Is(err, repository.ErrInvalidSort) ||
+			errors.Is(err, repository.ErrInvalidAttributeFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	products, err := s.priceProducts(ctx, models.PriceSelector{PriceListID: req.PriceListId, Currency: req.CurrencyCode}, page.Products)
 	if err != nil {
 		return nil, err
 	}
 
-	var protoProducts []*inventoryv1.ProductInfo
-	for _, p := range products {
-		protoProducts = append(protoProducts, &inventoryv1.ProductInfo{
-			ProductId: p.ProductID,
-			Name:      p.Name,
-			Price:     p.Price,
-			Quantity:  p.Quantity,
-		})
+	return &inventoryv1.ListProductsResponse{Products: toProtoProducts(products), NextPageToken: page.NextPageToken}, nil
+}
+
+func (s *InventoryHandler) SearchProducts(ctx context.Context, req *inventoryv1.SearchProductsRequest) (*inventoryv1.SearchProductsResponse, error) {
+	page, err := s.service.SearchProducts(ctx, models.SearchQuery{Query: req.Query, PageSize: int(req.PageSize), PageToken: req.PageToken})
+	if err != nil {
+		if errors.Is(err, repository.ErrEmptySearchQuery) || errors.Is(err, repository.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	products := make([]models.ProductStock, len(page.Hits))
+	for i, hit := range page.Hits {
+		products[i] = hit.ProductStock
+	}
+	products, err = s.priceProducts(ctx, models.PriceSelector{PriceListID: req.PriceListId, Currency: req.CurrencyCode}, products)
+	if err != nil {
+		return nil, err
 	}
 
-	return &inventoryv1.ListProductsResponse{Products: protoProducts}, nil
+	var hits []*inventoryv1.SearchHit
+	for i, hit := range page.Hits {
+		hits = append(hits, &inventoryv1.SearchHit{Product: toProtoProduct(products[i]), Rank: hit.Rank})
+	}
+	return &inventoryv1.SearchProductsResponse{Hits: hits, NextPageToken: page.NextPageToken}, nil
 }
 
 func (s *InventoryHandler) CreateProduct(ctx context.Context, req *inventoryv1.CreateProductRequest) (*inventoryv1.CreateProductResponse, error) {
-	success, msg, err := s.service.CreateProduct(ctx, req.ProductId, req.Name, req.Price, req.Quantity)
+	success, msg, err := s.service.CreateProduct(ctx, req.ProductId, req.Name, req.Description, toMoney(req.UnitPrice, req.Price), req.Quantity)
 	if err != nil {
 		return nil, err
 	}
 	return &inventoryv1.CreateProductResponse{Success: success, Message: msg}, nil
 }
 
+// UpdateProduct replaces every catalog field, or with an update mask only the listed ones.
+// The deprecated quantity field is ignored either way.
 func (s *InventoryHandler) UpdateProduct(ctx context.Context, req *inventoryv1.UpdateProductRequest) (*inventoryv1.UpdateProductResponse, error) {
-	success, msg, err := s.service.UpdateProduct(ctx, req.ProductId, req.Name, req.Price, req.Quantity)
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		success, msg, err := s.service.UpdateProduct(ctx, req.ProductId, req.ExpectedVersion, false, req.Name, req.Description, toMoney(req.UnitPrice, req.Price))
+		if err != nil {
+			return nil, toVersionStatusError(err)
+		}
+		return &inventoryv1.UpdateProductResponse{Success: success, Message: msg}, nil
+	}
+
+	patch, err := toProductPatch(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	patch.Version = req.ExpectedVersion
+	product, err := s.service.PatchProduct(ctx, req.ProductId, patch)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrInvalidPrice), errors.Is(err, repository.ErrInvalidAttributeValue):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, repository.ErrCurrencyMismatch):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, toVersionStatusError(err)
+	}
+	return &inventoryv1.UpdateProductResponse{Success: true, Message: "Product updated successfully", Product: toProtoProduct(product)}, nil
+}
+
+func (s *InventoryHandler) AdjustStock(ctx context.Context, req *inventoryv1.AdjustStockRequest) (*inventoryv1.AdjustStockResponse, error) {
+	product, err := s.service.AdjustStock(ctx, req.ProductId, req.Delta, req.Reason)
 	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidAdjustment):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, repository.ErrProductNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, repository.ErrNegativeStock), errors.Is(err, repository.ErrProductHasVariants),
+			errors.Is(err, repository.ErrProductIsBundle):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, err
 	}
-	return &inventoryv1.UpdateProductResponse{Success: success, Message: msg}, nil
+	return &inventoryv1.AdjustStockResponse{Product: toProtoProduct(product)}, nil
 }
 
 func (s *InventoryHandler) DeleteProduct(ctx context.Context, req *inventoryv1.DeleteProductRequest) (*inventoryv1.DeleteProductResponse, error) {
@@ -115,6 +265,21 @@ func (s *InventoryHandler) DeleteProduct(ctx context.Context, req *inventoryv1.D
 	return &inventoryv1.DeleteProductResponse{Success: success, Message: msg}, nil
 }
 
+func (s *InventoryHandler) RestoreProduct(ctx context.Context, req *inventoryv1.RestoreProductRequest) (*inventoryv1.RestoreProductResponse, error) {
+	product, err := s.service.RestoreProduct(ctx, req.ProductId)
+	if err != nil {
+		return nil, toArchiveStatusError(err)
+	}
+	return &inventoryv1.RestoreProductResponse{Product: toProtoProduct(product)}, nil
+}
+
+func (s *InventoryHandler) PurgeProduct(ctx context.Context, req *inventoryv1.PurgeProductRequest) (*inventoryv1.PurgeProductResponse, error) {
+	if err := s.service.PurgeProduct(ctx, req.ProductId); err != nil {
+		return nil, toArchiveStatusError(err)
+	}
+	return &inventoryv1.PurgeProductResponse{Success: true, Message: "Product purged successfully"}, nil
+}
+
 func (s *InventoryHandler) RestockItems(ctx context.Context, req *inventoryv1.RestockItemsRequest) (*inventoryv1.RestockItemsResponse, error) {
 	success, msg, err := s.service.RestockItems(ctx, req.ProductId, req.Quantity)
 	if err != nil {
@@ -122,3 +287,185 @@ func (s *InventoryHandler) RestockItems(ctx context.Context, req *inventoryv1.Re
 	}
 	return &inventoryv1.RestockItemsResponse{Success: success, Message: msg}, nil
 }
+
+func toVersionStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrVersionRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrVersionConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+// toProductPatch keeps only the fields named in the request's update mask. The deprecated
+// "price" path is accepted as an alias of "unit_price".
+func toProductPatch(req *inventoryv1.UpdateProductRequest) (models.ProductPatch, error) {
+	var patch models.ProductPatch
+	for _, path := range req.UpdateMask.Paths {
+		switch path {
+		case "name":
+			patch.Name = &req.Name
+		case "description":
+			patch.Description = &req.Description
+		case "unit_price", "price":
+			price := toMoney(req.UnitPrice, req.Price)
+			patch.Price = &price
+		case "attributes":
+			patch.Attributes = req.Attributes.AsMap()
+		case "quantity":
+			return patch, errors.New("quantity cannot be updated; use AdjustStock")
+		default:
+			return patch, fmt.Errorf("unknown update_mask path %q", path)
+		}
+	}
+	return patch, nil
+}
+
+func toArchiveStatusError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrProductArchived),
+		errors.Is(err, repository.ErrProductNotArchived),
+		errors.Is(err, repository.ErrOpenReservations),
+		errors.Is(err, repository.ErrBundleComponent):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+func toProtoItemResults(results []models.BatchItemResult) []*inventoryv1.BatchItemResult {
+	var protoResults []*inventoryv1.BatchItemResult
+	for _, result := range results {
+		protoResults = append(protoResults, &inventoryv1.BatchItemResult{
+			ProductId:         result.ProductID,
+			RequestedQuantity: result.Requested,
+			GrantedQuantity:   result.Granted,
+			Status:            toProtoItemStatus(result.Status),
+			AvailableQuantity: result.Available,
+		})
+	}
+	return protoResults
+}
+
+func toProtoProduct(p models.ProductStock) *inventoryv1.ProductInfo {
+	info := &inventoryv1.ProductInfo{
+		ProductId:         p.ProductID,
+		Name:              p.Name,
+		Description:       p.Description,
+		Price:             p.Price,
+		Quantity:          p.Quantity,
+		Reserved:          p.Reserved,
+		Options:           p.Options,
+		PriceOverride:     p.PriceOverride,
+		Variants:          toProtoProducts(p.Variants),
+		ArchivedAt:        optionalTimestamp(p.ArchivedAt),
+		Version:           p.Version,
+		UnitPrice:         toProtoMoney(p.UnitPrice),
+		UnitPriceOverride: p.UnitPriceOverride,
+		PriceListId:       p.PriceListID,
+		PriceScheduleId:   p.PriceScheduleID,
+		Images:            toProtoImages(p.Images),
+		Attributes:        toProtoAttributes(p.Attributes),
+		Components:        toProtoComponents(p.Components),
+	}
+	if p.ParentID != nil {
+		info.ParentId = *p.ParentID
+	}
+	return info
+}
+
+// toMoney reads a request price, falling back to the deprecated decimal field, which is
+// taken to be in the default currency.
+func toMoney(price *inventoryv1.Money, deprecated float64) models.Money {
+	if price != nil {
+		return models.Money{MinorUnits: price.MinorUnits, Currency: price.CurrencyCode}
+	}
+	return models.MoneyFromFloat(deprecated, models.DefaultCurrency)
+}
+
+// toMinorUnits is toMoney for price filters, where zero means unset.
+func toMinorUnits(minorUnits int64, deprecated float64) int64 {
+	if minorUnits == 0 && deprecated != 0 {
+		return models.MoneyFromFloat(deprecated, models.DefaultCurrency).MinorUnits
+	}
+	return minorUnits
+}
+
+func toProtoImages(images []models.ProductImage) []*inventoryv1.ProductImage {
+	var protoImages []*inventoryv1.ProductImage
+	for _, image := range images {
+		protoImages = append(protoImages, &inventoryv1.ProductImage{
+			ImageId:      image.ID,
+			Position:     image.Position,
+			ThumbnailUrl: image.ThumbnailURL,
+			DetailUrl:    image.DetailURL,
+			Width:        image.Width,
+			Height:       image.Height,
+		})
+	}
+	return protoImages
+}
+
+func toProtoProducts(products []models.ProductStock) []*inventoryv1.ProductInfo {
+	var protoProducts []*inventoryv1.ProductInfo
+	for _, p := range products {
+		protoProducts = append(protoProducts, toProtoProduct(p))
+	}
+	return protoProducts
+}
+
+func toProductSort(field inventoryv1.ProductSortField) models.ProductSort {
+	switch field {
+	case inventoryv1.ProductSortField_PRODUCT_SORT_FIELD_NAME:
+		return models.SortByName
+	case inventoryv1.ProductSortField_PRODUCT_SORT_FIELD_PRICE:
+		return models.SortByPrice
+	case inventoryv1.ProductSortField_PRODUCT_SORT_FIELD_QUANTITY:
+		return models.SortByQuantity
+	case inventoryv1.ProductSortField_PRODUCT_SORT_FIELD_UPDATED_AT:
+		return models.SortByUpdatedAt
+	default:
+		return models.SortByProductID
+	}
+}
+
+func toProtoItemStatus(status models.ItemStatus) inventoryv1.ItemStatus {
+	switch status {
+	case models.ItemOK:
+		return inventoryv1.ItemStatus_ITEM_STATUS_OK
+	case models.ItemNotFound:
+		return inventoryv1.ItemStatus_ITEM_STATUS_NOT_FOUND
+	case models.ItemInsufficient:
+		return inventoryv1.ItemStatus_ITEM_STATUS_INSUFFICIENT
+	case models.ItemInactive:
+		return inventoryv1.ItemStatus_ITEM_STATUS_INACTIVE
+	case models.ItemHasVariants:
+		return inventoryv1.ItemStatus_ITEM_STATUS_HAS_VARIANTS
+	default:
+		return inventoryv1.ItemStatus_ITEM_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoReservationStatus(status models.ReservationStatus) inventoryv1.ReservationStatus {
+	switch status {
+	case models.ReservationPending:
+		return inventoryv1.ReservationStatus_RESERVATION_STATUS_PENDING
+	case models.ReservationConfirmed:
+		return inventoryv1.ReservationStatus_RESERVATION_STATUS_CONFIRMED
+	case models.ReservationReleased:
+		return inventoryv1.ReservationStatus_RESERVATION_STATUS_RELEASED
+	default:
+		return inventoryv1.ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,250 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	inventoryv1 "inventory-service/proto/inventory/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *InventoryHandler) GetProduct(ctx context.Context, req *inventoryv1.GetProductRequest) (*inventoryv1.GetProductResponse, error) {
+	product, err := s.service.GetProduct(ctx, req.ProductId)
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	priced, err := s.pricing.PriceProducts(ctx, models.PriceSelector{PriceListID: req.PriceListId, Currency: req.CurrencyCode}, []models.ProductStock{product})
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.GetProductResponse{Product: toProtoProduct(priced[0])}, nil
+}
+
+func (s *InventoryHandler) CreatePriceList(ctx context.Context, req *inventoryv1.CreatePriceListRequest) (*inventoryv1.CreatePriceListResponse, error) {
+	list, err := s.pricing.CreatePriceList(ctx, toPriceList(req.PriceList))
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.CreatePriceListResponse{PriceList: toProtoPriceList(list)}, nil
+}
+
+func (s *InventoryHandler) UpdatePriceList(ctx context.Context, req *inventoryv1.UpdatePriceListRequest) (*inventoryv1.UpdatePriceListResponse, error) {
+	list, err := s.pricing.UpdatePriceList(ctx, toPriceList(req.PriceList))
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.UpdatePriceListResponse{PriceList: toProtoPriceList(list)}, nil
+}
+
+func (s *InventoryHandler) DeletePriceList(ctx context.Context, req *inventoryv1.DeletePriceListRequest) (*inventoryv1.DeletePriceListResponse, error) {
+	if err := s.pricing.DeletePriceList(ctx, req.PriceListId); err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.DeletePriceListResponse{Success: true, Message: "Price list deleted successfully"}, nil
+}
+
+func (s *InventoryHandler) GetPriceList(ctx context.Context, req *inventoryv1.GetPriceListRequest) (*inventoryv1.GetPriceListResponse, error) {
+	list, err := s.pricing.GetPriceList(ctx, req.PriceListId)
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.GetPriceListResponse{PriceList: toProtoPriceList(list)}, nil
+}
+
+func (s *InventoryHandler) ListPriceLists(ctx context.Context, req *inventoryv1.ListPriceListsRequest) (*inventoryv1.ListPriceListsResponse, error) {
+	lists, err := s.pricing.ListPriceLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var protoLists []*inventoryv1.PriceList
+	for _, list := range lists {
+		protoLists = append(protoLists, toProtoPriceList(list))
+	}
+	return &inventoryv1.ListPriceListsResponse{PriceLists: protoLists}, nil
+}
+
+func (s *InventoryHandler) SetListPrice(ctx context.Context, req *inventoryv1.SetListPriceRequest) (*inventoryv1.SetListPriceResponse, error) {
+	entry, err := s.pricing.SetPrice(ctx, req.PriceListId, req.ProductId, req.MinorUnits)
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.SetListPriceResponse{PriceListId: entry.PriceListID, ProductId: entry.ProductID, MinorUnits: entry.MinorUnits}, nil
+}
+
+func (s *InventoryHandler) DeleteListPrice(ctx context.Context, req *inventoryv1.DeleteListPriceRequest) (*inventoryv1.DeleteListPriceResponse, error) {
+	if err := s.pricing.DeletePrice(ctx, req.PriceListId, req.ProductId); err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.DeleteListPriceResponse{Success: true, Message: "List price deleted successfully"}, nil
+}
+
+func (s *InventoryHandler) SetExchangeRate(ctx context.Context, req *inventoryv1.SetExchangeRateRequest) (*inventoryv1.SetExchangeRateResponse, error) {
+	rate, err := s.pricing.SetExchangeRate(ctx, req.BaseCurrency, req.QuoteCurrency, req.Rate)
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.SetExchangeRateResponse{ExchangeRate: toProtoExchangeRate(rate)}, nil
+}
+
+func (s *InventoryHandler) DeleteExchangeRate(ctx context.Context, req *inventoryv1.DeleteExchangeRateRequest) (*inventoryv1.DeleteExchangeRateResponse, error) {
+	if err := s.pricing.DeleteExchangeRate(ctx, req.BaseCurrency, req.QuoteCurrency); err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.DeleteExchangeRateResponse{Success: true, Message: "Exchange rate deleted successfully"}, nil
+}
+
+func (s *InventoryHandler) ListExchangeRates(ctx context.Context, req *inventoryv1.ListExchangeRatesRequest) (*inventoryv1.ListExchangeRatesResponse, error) {
+	rates, err := s.pricing.ListExchangeRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var protoRates []*inventoryv1.ExchangeRate
+	for _, rate := range rates {
+		protoRates = append(protoRates, toProtoExchangeRate(rate))
+	}
+	return &inventoryv1.ListExchangeRatesResponse{ExchangeRates: protoRates}, nil
+}
+
+// priceProducts reprices products for a listing request; an empty selector keeps them as is.
+func (s *InventoryHandler) priceProducts(ctx context.Context, selector models.PriceSelector, products []models.ProductStock) ([]models.ProductStock, error) {
+	priced, err := s.pricing.PriceProducts(ctx, selector, products)
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return priced, nil
+}
+
+func toPricingStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPriceList),
+		errors.Is(err, service.ErrInvalidExchangeRate),
+		errors.Is(err, service.ErrInvalidCurrency),
+		errors.Is(err, service.ErrInvalidPrice),
+		errors.Is(err, service.ErrPriceListCurrency),
+		errors.Is(err, service.ErrInvalidPriceSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrPriceListNotFound),
+		errors.Is(err, repository.ErrPriceListEntryNotFound),
+		errors.Is(err, repository.ErrExchangeRateNotFound),
+		errors.Is(err, repository.ErrProductNotFound),
+		errors.Is(err, repository.ErrPriceScheduleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrPriceListExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrNoExchangeRate),
+		errors.Is(err, repository.ErrPriceScheduleOverlap),
+		errors.Is(err, repository.ErrPriceScheduleCurrency):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+func toPriceList(list *inventoryv1.PriceList) models.PriceList {
+	if list == nil {
+		return models.PriceList{}
+	}
+	return models.PriceList{
+		ID:                list.PriceListId,
+		Name:              list.Name,
+		Currency:          list.CurrencyCode,
+		Rounding:          toRoundingMode(list.Rounding),
+		RoundingIncrement: list.RoundingIncrement,
+	}
+}
+
+func toProtoPriceList(list models.PriceList) *inventoryv1.PriceList {
+	return &inventoryv1.PriceList{
+		PriceListId:       list.ID,
+		Name:              list.Name,
+		CurrencyCode:      list.Currency,
+		Rounding:          toProtoRoundingMode(list.Rounding),
+		RoundingIncrement: list.RoundingIncrement,
+	}
+}
+
+func toProtoExchangeRate(rate models.ExchangeRate) *inventoryv1.ExchangeRate {
+	return &inventoryv1.ExchangeRate{
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		UpdatedAt:     timestamppb.New(rate.UpdatedAt),
+	}
+}
+
+func toRoundingMode(mode inventoryv1.RoundingMode) models.RoundingMode {
+	switch mode {
+	case inventoryv1.RoundingMode_ROUNDING_MODE_UP:
+		return models.RoundUp
+	case inventoryv1.RoundingMode_ROUNDING_MODE_DOWN:
+		return models.RoundDown
+	default:
+		return models.RoundHalfUp
+	}
+}
+
+func toProtoRoundingMode(mode models.RoundingMode) inventoryv1.RoundingMode {
+	switch mode {
+	case models.RoundUp:
+		return inventoryv1.RoundingMode_ROUNDING_MODE_UP
+	case models.RoundDown:
+		return inventoryv1.RoundingMode_ROUNDING_MODE_DOWN
+	default:
+		return inventoryv1.RoundingMode_ROUNDING_MODE_HALF_UP
+	}
+}
+
+func (s *InventoryHandler) CreatePriceSchedule(ctx context.Context, req *inventoryv1.CreatePriceScheduleRequest) (*inventoryv1.CreatePriceScheduleResponse, error) {
+	schedule, err := s.pricing.CreatePriceSchedule(ctx, toPriceSchedule(req.Schedule))
+	if err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.CreatePriceScheduleResponse{Schedule: toProtoPriceSchedule(schedule)}, nil
+}
+
+func (s *InventoryHandler) ListPriceSchedules(ctx context.Context, req *inventoryv1.ListPriceSchedulesRequest) (*inventoryv1.ListPriceSchedulesResponse, error) {
+	schedules, err := s.pricing.ListPriceSchedules(ctx, req.ProductId, req.IncludeEnded)
+	if err != nil {
+		return nil, err
+	}
+	var protoSchedules []*inventoryv1.PriceSchedule
+	for _, schedule := range schedules {
+		protoSchedules = append(protoSchedules, toProtoPriceSchedule(schedule))
+	}
+	return &inventoryv1.ListPriceSchedulesResponse{Schedules: protoSchedules}, nil
+}
+
+func (s *InventoryHandler) CancelPriceSchedule(ctx context.Context, req *inventoryv1.CancelPriceScheduleRequest) (*inventoryv1.CancelPriceScheduleResponse, error) {
+	if err := s.pricing.CancelPriceSchedule(ctx, req.ProductId, req.ScheduleId); err != nil {
+		return nil, toPricingStatusError(err)
+	}
+	return &inventoryv1.CancelPriceScheduleResponse{Success: true, Message: "Price schedule cancelled successfully"}, nil
+}
+
+func toPriceSchedule(schedule *inventoryv1.PriceSchedule) models.PriceSchedule {
+	if schedule == nil {
+		return models.PriceSchedule{}
+	}
+	return models.PriceSchedule{
+		ProductID: schedule.ProductId,
+		UnitPrice: models.Money{MinorUnits: schedule.UnitPrice.GetMinorUnits(), Currency: schedule.UnitPrice.GetCurrencyCode()},
+		StartsAt:  schedule.StartsAt.AsTime(),
+		EndsAt:    schedule.EndsAt.AsTime(),
+	}
+}
+
+func toProtoPriceSchedule(schedule models.PriceSchedule) *inventoryv1.PriceSchedule {
+	return &inventoryv1.PriceSchedule{
+		ScheduleId: schedule.ID,
+		ProductId:  schedule.ProductID,
+		UnitPrice:  toProtoMoney(schedule.UnitPrice),
+		StartsAt:   timestamppb.New(schedule.StartsAt),
+		EndsAt:     timestamppb.New(schedule.EndsAt),
+		CreatedAt:  timestamppb.New(schedule.CreatedAt),
+	}
+}
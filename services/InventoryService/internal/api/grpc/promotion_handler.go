@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"inventory-service/internal/models"
+	inventoryv1 "inventory-service/proto/inventory/v1"
+)
+
+func (s *InventoryHandler) ListOffers(ctx context.Context, req *inventoryv1.ListOffersRequest) (*inventoryv1.ListOffersResponse, error) {
+	offers, err := s.promotions.ListOffers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var protoOffers []*inventoryv1.Offer
+	for _, offer := range offers {
+		protoOffers = append(protoOffers, &inventoryv1.Offer{
+			Product:         toProtoProduct(offer.Product),
+			OriginalPrice:   toProtoMoney(offer.OriginalPrice),
+			DiscountedPrice: toProtoMoney(offer.DiscountedPrice),
+			Promotion:       toProtoPromotion(offer.Promotion),
+		})
+	}
+	return &inventoryv1.ListOffersResponse{Offers: protoOffers}, nil
+}
+
+func toProtoMoney(price models.Money) *inventoryv1.Money {
+	return &inventoryv1.Money{MinorUnits: price.MinorUnits, CurrencyCode: price.Currency}
+}
+
+func toProtoPromotion(promotion models.Promotion) *inventoryv1.Promotion {
+	return &inventoryv1.Promotion{
+		PromotionId:      promotion.ID,
+		Name:             promotion.Name,
+		DiscountType:     toProtoDiscountType(promotion.DiscountType),
+		DiscountValue:    promotion.DiscountValue,
+		DiscountCurrency: promotion.DiscountCurrency,
+		Priority:         promotion.Priority,
+		StartsAt:         optionalTimestamp(promotion.StartsAt),
+		EndsAt:           optionalTimestamp(promotion.EndsAt),
+	}
+}
+
+func toProtoDiscountType(discountType models.DiscountType) inventoryv1.DiscountType {
+	switch discountType {
+	case models.DiscountPercent:
+		return inventoryv1.DiscountType_DISCOUNT_TYPE_PERCENT
+	case models.DiscountFixed:
+		return inventoryv1.DiscountType_DISCOUNT_TYPE_FIXED
+	default:
+		return inventoryv1.DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+	}
+}
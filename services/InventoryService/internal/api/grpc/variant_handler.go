@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	inventoryv1 "inventory-service/proto/inventory/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *InventoryHandler) CreateVariant(ctx context.Context, req *inventoryv1.CreateVariantRequest) (*inventoryv1.CreateVariantResponse, error) {
+	variant, err := s.service.CreateVariant(ctx, req.ParentId, req.ProductId, req.Name, req.Options, toPriceOverride(req.UnitPriceOverride, req.PriceOverride), req.Quantity)
+	if err != nil {
+		return nil, toVariantStatusError(err)
+	}
+	return &inventoryv1.CreateVariantResponse{Variant: toProtoProduct(variant)}, nil
+}
+
+func (s *InventoryHandler) UpdateVariant(ctx context.Context, req *inventoryv1.UpdateVariantRequest) (*inventoryv1.UpdateVariantResponse, error) {
+	variant, err := s.service.UpdateVariant(ctx, req.ProductId, req.Options, toPriceOverride(req.UnitPriceOverride, req.PriceOverride))
+	if err != nil {
+		return nil, toVariantStatusError(err)
+	}
+	return &inventoryv1.UpdateVariantResponse{Variant: toProtoProduct(variant)}, nil
+}
+
+func (s *InventoryHandler) ListVariants(ctx context.Context, req *inventoryv1.ListVariantsRequest) (*inventoryv1.ListVariantsResponse, error) {
+	variants, err := s.service.ListVariants(ctx, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv1.ListVariantsResponse{Variants: toProtoProducts(variants)}, nil
+}
+
+// toPriceOverride reads a variant's price override in minor units, falling back to the
+// deprecated decimal field, which is taken to have the default currency's minor unit.
+func toPriceOverride(minorUnits *int64, deprecated *float64) *int64 {
+	if minorUnits != nil || deprecated == nil {
+		return minorUnits
+	}
+	override := models.MoneyFromFloat(*deprecated, models.DefaultCurrency).MinorUnits
+	return &override
+}
+
+func toVariantStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidVariant), errors.Is(err, service.ErrInvalidPrice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrDuplicateVariant):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrNestedVariant),
+		errors.Is(err, repository.ErrNotAVariant),
+		errors.Is(err, repository.ErrParentHasStock),
+		errors.Is(err, repository.ErrProductIsBundle),
+		errors.Is(err, repository.ErrProductArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
@@ -2,8 +2,13 @@ package rest
 
 import (
 	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
 	"inventory-service/internal/service"
 	"net/http"
+	"strconv"
+	"strings"
 	"github.com/danielgtaylor/huma/v2"
 )
 
@@ -17,7 +22,7 @@ func RegisterAdminHandlers(api huma.API, svc service.InventoryService) {
 		Summary:     "Create new product",
 		Tags:        []string{"Admin"},
 	}, func(ctx context.Context, input *CreateProductRequest) (*SuccessResponse, error) {
-		success, msg, err := svc.CreateProduct(ctx, input.Body.ProductID, input.Body.Name, input.Body.Price, input.Body.Quantity)
+		success, msg, err := svc.CreateProduct(ctx, input.Body.ProductID, input.Body.Name, input.Body.Description, priceOrDeprecated(input.Body.UnitPrice, input.Body.Price), input.Body.Quantity)
 		if err != nil {
 			return nil, huma.Error500InternalServerError(err.Error())
 		}
@@ -34,21 +39,75 @@ func RegisterAdminHandlers(api huma.API, svc service.InventoryService) {
 		Summary:     "Update product",
 		Tags:        []string{"Admin"},
 	}, func(ctx context.Context, input *UpdateProductRequest) (*SuccessResponse, error) {
-		success, msg, err := svc.UpdateProduct(ctx, input.ID, input.Body.Name, input.Body.Price, input.Body.Quantity)
+		version, anyVersion, err := expectedVersion(input.IfMatch, input.Body.Version)
 		if err != nil {
-			return nil, huma.Error500InternalServerError(err.Error())
+			return nil, err
+		}
+		success, msg, err := svc.UpdateProduct(ctx, input.ID, version, anyVersion, input.Body.Name, input.Body.Description, priceOrDeprecated(input.Body.UnitPrice, input.Body.Price))
+		if err != nil {
+			return nil, toVersionHTTPError(err)
 		}
 		return &SuccessResponse{
 			Body: SuccessBody{Success: success, Message: msg},
 		}, nil
 	})
 
-	// Delete a product
+	// Change only the fields present in the body; stock is never touched
+	huma.Register(api, huma.Operation{
+		OperationID: "patch-product",
+		Method:      http.MethodPatch,
+		Path:        "/api/inventory/active-products/{id}",
+		Summary:     "Partially update product",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *PatchProductRequest) (*ProductResponse, error) {
+		version, anyVersion, err := expectedVersion(input.IfMatch, input.Body.Version)
+		if err != nil {
+			return nil, err
+		}
+		patch := models.ProductPatch{Version: version, AnyVersion: anyVersion, Name: input.Body.Name, Description: input.Body.Description, Price: input.Body.price(), Attributes: input.Body.Attributes}
+		product, err := svc.PatchProduct(ctx, input.ID, patch)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidPrice), errors.Is(err, repository.ErrInvalidAttributeValue):
+				return nil, huma.Error400BadRequest(err.Error())
+			case errors.Is(err, repository.ErrCurrencyMismatch):
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, toVersionHTTPError(err)
+		}
+		return toProductResponse(product), nil
+	})
+
+	// Correct available stock by a signed amount
+	huma.Register(api, huma.Operation{
+		OperationID: "adjust-stock",
+		Method:      http.MethodPost,
+		Path:        "/api/inventory/active-products/{id}/adjustments",
+		Summary:     "Adjust product stock",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *AdjustStockRequest) (*ProductResponse, error) {
+		product, err := svc.AdjustStock(ctx, input.ID, input.Body.Delta, input.Body.Reason)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidAdjustment):
+				return nil, huma.Error400BadRequest(err.Error())
+			case errors.Is(err, repository.ErrProductNotFound):
+				return nil, huma.Error404NotFound(err.Error())
+			case errors.Is(err, repository.ErrNegativeStock), errors.Is(err, repository.ErrProductHasVariants),
+				errors.Is(err, repository.ErrProductIsBundle):
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		return toProductResponse(product), nil
+	})
+
+	// Archive a product; open reservations can still be released
 	huma.Register(api, huma.Operation{
 		OperationID: "delete-product",
 		Method:      http.MethodDelete,
 		Path:        "/api/inventory/active-products/{id}",
-		Summary:     "Delete product",
+		Summary:     "Archive product",
 		Tags:        []string{"Admin"},
 	}, func(ctx context.Context, input *AdminDeleteRequest) (*SuccessResponse, error) {
 		success, msg, err := svc.DeleteProduct(ctx, input.ID)
@@ -59,4 +118,84 @@ func RegisterAdminHandlers(api huma.API, svc service.InventoryService) {
 			Body: SuccessBody{Success: success, Message: msg},
 		}, nil
 	})
+
+	// Bring an archived product back
+	huma.Register(api, huma.Operation{
+		OperationID: "restore-product",
+		Method:      http.MethodPost,
+		Path:        "/api/inventory/archived-products/{id}/restore",
+		Summary:     "Restore archived product",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *ProductIDParam) (*ProductResponse, error) {
+		product, err := svc.RestoreProduct(ctx, input.ID)
+		if err != nil {
+			return nil, toArchiveHTTPError(err)
+		}
+		return toProductResponse(product), nil
+	})
+
+	// Permanently delete an archived product
+	huma.Register(api, huma.Operation{
+		OperationID: "purge-product",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/archived-products/{id}",
+		Summary:     "Purge archived product",
+		Description: "Refused with 409 while any reservation still holds the product or one of its variants.",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *ProductIDParam) (*SuccessResponse, error) {
+		if err := svc.PurgeProduct(ctx, input.ID); err != nil {
+			return nil, toArchiveHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Product purged successfully"},
+		}, nil
+	})
+}
+
+func toArchiveHTTPError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrProductNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, repository.ErrProductArchived),
+		errors.Is(err, repository.ErrProductNotArchived),
+		errors.Is(err, repository.ErrOpenReservations),
+		errors.Is(err, repository.ErrBundleComponent):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
+
+// expectedVersion reads the version an update was based on from If-Match, falling back to
+// the version in the body. Only "*" matches any version of an existing product; a weak ETag,
+// or one that cannot be one of ours, never matches.
+func expectedVersion(ifMatch string, bodyVersion int64) (int64, bool, error) {
+	if ifMatch == "" {
+		return bodyVersion, false, nil
+	}
+	if strings.TrimSpace(ifMatch) == "*" {
+		return 0, true, nil
+	}
+	tag := strings.TrimSpace(ifMatch)
+	if strings.HasPrefix(tag, "W/") {
+		return 0, false, huma.Error412PreconditionFailed("If-Match needs a strong ETag")
+	}
+	version, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false, huma.Error412PreconditionFailed("If-Match does not match the current product version")
+	}
+	return version, false, nil
+}
+
+func toVersionHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrVersionRequired):
+		return huma.Error428PreconditionRequired(err.Error())
+	case errors.Is(err, repository.ErrVersionConflict):
+		return huma.Error412PreconditionFailed(err.Error())
+	case errors.Is(err, repository.ErrProductNotFound):
+		return huma.Error404NotFound(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
 }
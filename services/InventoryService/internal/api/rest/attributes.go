@@ -0,0 +1,219 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+const attributeFiltersDoc = "Query parameters other than the ones below filter on product attributes, e.g. " +
+	"`connectivity=bluetooth&size_in>=27`. Every filter must hold. Numbers can be compared with `=`, `!=`, `<`, " +
+	"`<=`, `>` and `>=`; other attributes with `=` and `!=`. `!=` also keeps products without the attribute."
+
+// listingQueryParams are the declared query parameters of the product listings, which are
+// never read as attribute filters.
+var listingQueryParams = queryParamNames(
+	reflect.TypeOf(ListActiveProductsRequest{}),
+	reflect.TypeOf(ListCategoryProductsRequest{}),
+)
+
+// filterOperators maps the query syntax to filter operators, longest first.
+var filterOperators = []struct {
+	token    string
+	operator models.RuleOperator
+}{
+	{">=", models.OpGreaterOrEqual},
+	{"<=", models.OpLessOrEqual},
+	{"!=", models.OpNotEqual},
+	{">", models.OpGreaterThan},
+	{"<", models.OpLessThan},
+	{"=", models.OpEqual},
+}
+
+func RegisterAttributeHandlers(api huma.API, categories service.CategoryService) {
+	// List attribute definitions
+	huma.Register(api, huma.Operation{
+		OperationID: "list-attribute-definitions",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/attributes",
+		Summary:     "List attribute definitions",
+		Tags:        []string{"Attributes"},
+	}, func(ctx context.Context, input *ListAttributeDefinitionsRequest) (*ListAttributeDefinitionsResponse, error) {
+		definitions, err := categories.ListAttributeDefinitions(ctx, input.Category)
+		if err != nil {
+			return nil, toAttributeHTTPError(err)
+		}
+		if definitions == nil {
+			definitions = []models.AttributeDefinition{}
+		}
+		return &ListAttributeDefinitionsResponse{Body: definitions}, nil
+	})
+
+	// Define an attribute (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "create-attribute-definition",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/attributes",
+		Summary:       "Create attribute definition",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusCreated,
+	}, func(ctx context.Context, input *CreateAttributeDefinitionRequest) (*AttributeDefinitionResponse, error) {
+		definition, err := categories.CreateAttributeDefinition(ctx, input.Body.toDefinition(input.Body.Key))
+		if err != nil {
+			return nil, toAttributeHTTPError(err)
+		}
+		return &AttributeDefinitionResponse{Body: definition}, nil
+	})
+
+	// Replace an attribute definition (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "update-attribute-definition",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/attributes/{key}",
+		Summary:     "Update attribute definition",
+		Description: "The type cannot change while products have a value for the attribute, nor can an enum drop a value still in use.",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *UpdateAttributeDefinitionRequest) (*AttributeDefinitionResponse, error) {
+		definition, err := categories.UpdateAttributeDefinition(ctx, input.Body.toDefinition(input.Key))
+		if err != nil {
+			return nil, toAttributeHTTPError(err)
+		}
+		return &AttributeDefinitionResponse{Body: definition}, nil
+	})
+
+	// Delete an attribute definition and every product's value for it (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-attribute-definition",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/attributes/{key}",
+		Summary:     "Delete attribute definition",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *AttributeKeyParam) (*SuccessResponse, error) {
+		if err := categories.DeleteAttributeDefinition(ctx, input.Key); err != nil {
+			return nil, toAttributeHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Attribute definition deleted successfully"},
+		}, nil
+	})
+}
+
+func (in AttributeDefinitionUpdateInput) toDefinition(key string) models.AttributeDefinition {
+	definition := models.AttributeDefinition{
+		Key:        key,
+		Name:       in.Name,
+		Type:       models.AttributeType(in.Type),
+		EnumValues: in.EnumValues,
+		Unit:       in.Unit,
+	}
+	if in.CategoryID != "" {
+		definition.CategoryID = &in.CategoryID
+	}
+	return definition
+}
+
+// Resolve reads the attribute filters of a product listing from the query parameters it
+// does not declare.
+func (p *ListProductsParams) Resolve(ctx huma.Context) []error {
+	var errs []error
+	for _, pair := range strings.Split(ctx.URL().RawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		filter, err := parseAttributeFilter(pair)
+		if err != nil {
+			errs = append(errs, &huma.ErrorDetail{Location: "query", Message: err.Error(), Value: pair})
+			continue
+		}
+		if listingQueryParams[filter.Key] {
+			continue
+		}
+		p.Attributes = append(p.Attributes, filter)
+	}
+	return errs
+}
+
+// parseAttributeFilter reads one query pair such as "size_in>=27". The operator is found
+// before unescaping, so an escaped one belongs to the name or the value.
+func parseAttributeFilter(pair string) (models.AttributeFilter, error) {
+	at := strings.IndexAny(pair, "<>!=")
+	if at < 0 {
+		// A bare name, such as a flag; only declared parameters may be written this way
+		key, err := url.QueryUnescape(pair)
+		return models.AttributeFilter{Key: key}, errors.Join(err, requireDeclared(key))
+	}
+	key, err := url.QueryUnescape(pair[:at])
+	if err != nil {
+		return models.AttributeFilter{}, err
+	}
+	for _, candidate := range filterOperators {
+		if !strings.HasPrefix(pair[at:], candidate.token) {
+			continue
+		}
+		value, err := url.QueryUnescape(pair[at+len(candidate.token):])
+		if err != nil {
+			return models.AttributeFilter{}, err
+		}
+		if candidate.operator != models.OpEqual {
+			if err := requireUndeclared(key); err != nil {
+				return models.AttributeFilter{}, err
+			}
+		}
+		return models.AttributeFilter{Key: key, Operator: candidate.operator, Value: value}, nil
+	}
+	return models.AttributeFilter{}, errors.New("unknown filter operator")
+}
+
+func requireDeclared(key string) error {
+	if listingQueryParams[key] {
+		return nil
+	}
+	return errors.New("attribute filter needs an operator and a value")
+}
+
+func requireUndeclared(key string) error {
+	if listingQueryParams[key] {
+		return errors.New("only attribute filters take comparison operators")
+	}
+	return nil
+}
+
+// queryParamNames collects the query parameter names of input structs, embedded ones included.
+func queryParamNames(types ...reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for _, t := range types {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				for name := range queryParamNames(field.Type) {
+					names[name] = true
+				}
+			}
+			if name := field.Tag.Get("query"); name != "" {
+				names[name] = true
+			}
+		}
+	}
+	return names
+}
+
+func toAttributeHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidAttributeDefinition):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, repository.ErrAttributeNotFound), errors.Is(err, repository.ErrCategoryNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, repository.ErrAttributeExists), errors.Is(err, repository.ErrAttributeInUse):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
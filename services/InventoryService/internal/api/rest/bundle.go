@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterBundleHandlers(api huma.API, svc service.InventoryService) {
+	// Define the components of a bundle (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "set-bundle-components",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/active-products/{id}/components",
+		Summary:     "Set bundle components",
+		Description: "Makes the product a bundle of the given components. A bundle holds no stock of its own: its quantity is " +
+			"how many units its components allow, and reserving it reserves its components, which releases return.",
+		Tags: []string{"Admin"},
+	}, func(ctx context.Context, input *SetBundleComponentsRequest) (*ProductResponse, error) {
+		components := make([]models.BundleComponent, 0, len(input.Body.Components))
+		for _, component := range input.Body.Components {
+			components = append(components, models.BundleComponent{ComponentID: component.ProductID, Quantity: component.Quantity})
+		}
+		bundle, err := svc.SetBundleComponents(ctx, input.ID, components)
+		if err != nil {
+			return nil, toBundleHTTPError(err)
+		}
+		return toProductResponse(bundle), nil
+	})
+}
+
+func toBundleHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidBundle):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, repository.ErrProductNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, repository.ErrBundleHasStock),
+		errors.Is(err, repository.ErrNestedBundle),
+		errors.Is(err, repository.ErrProductHasVariants),
+		errors.Is(err, repository.ErrProductArchived):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
@@ -0,0 +1,118 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+const catalogImportDoc = "Queues the file and returns the job at once; poll the job for its progress and row errors. " +
+	"Products are upserted by product ID: new ones are created, existing ones have their catalog fields replaced. " +
+	"Stock is only set for new products. Variants need their parent to exist or to come earlier in the file, and " +
+	"bundles their components. CSV columns are product_id, parent_id, name, description, currency, price (a decimal " +
+	"such as 12.99), quantity, options and attributes (JSON objects), categories (IDs separated by |) and components " +
+	"(a JSON array of productId and quantity); only product_id is required, and a " +
+	"missing column leaves that field alone. JSON files are an array of products shaped like the export."
+
+var catalogContentTypes = map[models.CatalogFormat]string{
+	models.CatalogCSV:  "text/csv; charset=utf-8",
+	models.CatalogJSON: "application/json",
+}
+
+func RegisterCatalogHandlers(api huma.API, catalog service.CatalogService) {
+	// Upload a catalog file to import in the background (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "start-catalog-import",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/catalog/imports",
+		Summary:       "Import catalog",
+		Description:   catalogImportDoc,
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusAccepted,
+		MaxBodyBytes:  service.MaxImportBytes + 1<<20, // Room for the multipart envelope
+	}, func(ctx context.Context, input *StartImportRequest) (*ImportJobResponse, error) {
+		file := input.RawBody.Data().File
+		format := models.CatalogFormat(input.Format)
+		if format == "" {
+			format = models.CatalogFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(file.Filename)), "."))
+		}
+		data, err := io.ReadAll(io.LimitReader(file, service.MaxImportBytes+1))
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		job, err := catalog.StartImport(ctx, format, input.DryRun, data)
+		if err != nil {
+			return nil, toCatalogHTTPError(err)
+		}
+		return &ImportJobResponse{Body: job}, nil
+	})
+
+	// Poll an import job (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "get-catalog-import",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/catalog/imports/{jobId}",
+		Summary:     "Get catalog import",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *ImportJobParams) (*ImportJobResponse, error) {
+		job, err := catalog.GetImportJob(ctx, input.JobID)
+		if err != nil {
+			return nil, toCatalogHTTPError(err)
+		}
+		return &ImportJobResponse{Body: job}, nil
+	})
+
+	// Download every product that is not archived (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "export-catalog",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/catalog/export",
+		Summary:     "Export catalog",
+		Description: "Streams the catalog in the import format, parents before their variants, so the file can be imported again.",
+		Tags:        []string{"Admin"},
+		Responses: map[string]*huma.Response{
+			"200": {
+				Description: "The catalog file",
+				Content: map[string]*huma.MediaType{
+					catalogContentTypes[models.CatalogCSV]:  {},
+					catalogContentTypes[models.CatalogJSON]: {},
+				},
+			},
+		},
+	}, func(ctx context.Context, input *ExportCatalogRequest) (*huma.StreamResponse, error) {
+		format := models.CatalogFormat(input.Format)
+		return &huma.StreamResponse{
+			Body: func(hctx huma.Context) {
+				hctx.SetHeader("Content-Type", catalogContentTypes[format])
+				hctx.SetHeader("Content-Disposition", fmt.Sprintf(`attachment; filename="catalog.%s"`, format))
+				// The status is sent with the first bytes, so a failure can only cut the file short
+				if err := catalog.ExportCatalog(ctx, format, hctx.BodyWriter()); err != nil {
+					slog.ErrorContext(ctx, "Catalog export failed", "format", format, "error", err)
+				}
+			},
+		}, nil
+	})
+}
+
+func toCatalogHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogFormat):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, service.ErrImportTooLarge):
+		return huma.Error413RequestEntityTooLarge(err.Error())
+	case errors.Is(err, repository.ErrImportJobNotFound):
+		return huma.Error404NotFound(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterCategoryHandlers(api huma.API, categories service.CategoryService, svc service.InventoryService, pricing service.PricingService) {
+	// List the whole category tree (flat, linked by parentId)
+	huma.Register(api, huma.Operation{
+		OperationID: "list-categories",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/categories",
+		Summary:     "List categories",
+		Tags:        []string{"Categories"},
+	}, func(ctx context.Context, input *struct{}) (*ListCategoriesResponse, error) {
+		list, err := categories.ListCategories(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		if list == nil {
+			list = []models.Category{}
+		}
+		return &ListCategoriesResponse{Body: list}, nil
+	})
+
+	// Get a single category
+	huma.Register(api, huma.Operation{
+		OperationID: "get-category",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/categories/{categoryId}",
+		Summary:     "Get category",
+		Tags:        []string{"Categories"},
+	}, func(ctx context.Context, input *CategoryIDParam) (*CategoryResponse, error) {
+		category, err := categories.GetCategory(ctx, input.CategoryID)
+		if err != nil {
+			return nil, toCategoryHTTPError(err)
+		}
+		return &CategoryResponse{Body: category}, nil
+	})
+
+	// List products in a category and all of its subcategories
+	huma.Register(api, huma.Operation{
+		OperationID: "list-category-products",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/categories/{categoryId}/products",
+		Summary:     "List products in a category subtree",
+		Description: attributeFiltersDoc,
+		Tags:        []string{"Categories"},
+	}, func(ctx context.Context, input *ListCategoryProductsRequest) (*ListProductsResponse, error) {
+		if _, err := categories.GetCategory(ctx, input.CategoryID); err != nil {
+			return nil, toCategoryHTTPError(err)
+		}
+		query := input.toQuery()
+		query.InStockOnly = input.InStock
+		query.CategoryID = input.CategoryID
+		return listProducts(ctx, svc, pricing, query, input.toSelector())
+	})
+
+	// Create a category (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "create-category",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/categories",
+		Summary:       "Create category",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusCreated,
+	}, func(ctx context.Context, input *CreateCategoryRequest) (*CategoryResponse, error) {
+		category, err := categories.CreateCategory(ctx, input.Body.ID, input.Body.Name, input.Body.ParentID)
+		if err != nil {
+			return nil, toCategoryHTTPError(err)
+		}
+		return &CategoryResponse{Body: category}, nil
+	})
+
+	// Rename or move a category (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "update-category",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/categories/{categoryId}",
+		Summary:     "Update category",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *UpdateCategoryRequest) (*CategoryResponse, error) {
+		category, err := categories.UpdateCategory(ctx, input.CategoryID, input.Body.Name, input.Body.ParentID)
+		if err != nil {
+			return nil, toCategoryHTTPError(err)
+		}
+		return &CategoryResponse{Body: category}, nil
+	})
+
+	// Delete a leaf category (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-category",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/categories/{categoryId}",
+		Summary:     "Delete category",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *CategoryIDParam) (*SuccessResponse, error) {
+		if err := categories.DeleteCategory(ctx, input.CategoryID); err != nil {
+			return nil, toCategoryHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Category deleted successfully"},
+		}, nil
+	})
+
+	// Replace a product's categories (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "set-product-categories",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/active-products/{id}/categories",
+		Summary:     "Set product categories",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *SetProductCategoriesRequest) (*ListCategoriesResponse, error) {
+		assigned, err := categories.SetProductCategories(ctx, input.ID, input.Body.CategoryIDs)
+		if err != nil {
+			return nil, toCategoryHTTPError(err)
+		}
+		if assigned == nil {
+			assigned = []models.Category{}
+		}
+		return &ListCategoriesResponse{Body: assigned}, nil
+	})
+}
+
+func toCategoryHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCategory):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, repository.ErrCategoryNotFound), errors.Is(err, repository.ErrProductNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, repository.ErrCategoryExists),
+		errors.Is(err, repository.ErrCategoryHasChildren),
+		errors.Is(err, repository.ErrCategoryCycle):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
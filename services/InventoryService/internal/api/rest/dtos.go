@@ -1,27 +1,78 @@
 package rest
 
-import "inventory-service/internal/models"
+import (
+	"fmt"
+	"inventory-service/internal/models"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+)
 
 // --- Named Data Models (DTOs) ---
 // These are reusable and act as your API "Contract"
 
 type ProductInput struct {
-	ProductID string  `json:"productId" example:"PROD-001" doc:"Unique ID for the product"`
-	Name      string  `json:"name"      example:"Laptop"   doc:"Product name"`
-	Price     float64 `json:"price"     example:"1200.00"  doc:"Unit price"`
-	Quantity  int32   `json:"quantity"  example:"100"      doc:"Initial stock level"`
+	ProductID   string        `json:"productId"             example:"PROD-001" doc:"Unique ID for the product"`
+	Name        string        `json:"name"                  example:"Laptop"   doc:"Product name"`
+	Description string        `json:"description,omitempty" example:"15-inch notebook with 32GB RAM" doc:"Searchable product description"`
+	UnitPrice   *models.Money `json:"unitPrice,omitempty"   doc:"Unit price in minor units, e.g. 120000 USD"`
+	Price       float64       `json:"price,omitempty"       example:"1200.00"  deprecated:"true" doc:"Use unitPrice; read as USD when unitPrice is omitted"`
+	Quantity    int32         `json:"quantity"              example:"100"      doc:"Initial stock level"`
 }
 
 type ProductUpdateInput struct {
-	Name     string  `json:"name"     example:"Gaming Laptop"`
-	Price    float64 `json:"price"    example:"1500.00"`
-	Quantity int32   `json:"quantity" example:"150"`
+	Name        string        `json:"name"                  example:"Gaming Laptop"`
+	Description string        `json:"description,omitempty" example:"15-inch notebook with 32GB RAM"`
+	UnitPrice   *models.Money `json:"unitPrice,omitempty"`
+	Price       float64       `json:"price,omitempty"       example:"1500.00" deprecated:"true" doc:"Use unitPrice; read as USD when unitPrice is omitted"`
+	Quantity    int32         `json:"quantity,omitempty"    example:"150" deprecated:"true" doc:"Ignored; change stock through the adjustments endpoint"`
+	Version     int64         `json:"version,omitempty"     example:"3" doc:"Version being edited; alternative to If-Match"`
+}
+
+// ProductPatchInput changes only the fields present in the body.
+type ProductPatchInput struct {
+	Name        *string                `json:"name,omitempty"        example:"Gaming Laptop"`
+	Description *string                `json:"description,omitempty" example:"15-inch notebook with 32GB RAM"`
+	UnitPrice   *models.Money          `json:"unitPrice,omitempty"`
+	Price       *float64               `json:"price,omitempty"       example:"1500.00" minimum:"0" deprecated:"true" doc:"Use unitPrice; read as USD when unitPrice is omitted"`
+	Attributes  models.AttributeValues `json:"attributes,omitempty"  doc:"Merged into the product's attributes and checked against their definitions; null removes an attribute"`
+	Version     int64                  `json:"version,omitempty"     example:"3" doc:"Version being edited; alternative to If-Match"`
+}
+
+type StockAdjustmentInput struct {
+	Delta  int32  `json:"delta"            example:"-3" doc:"Added to available stock; negative to write stock off"`
+	Reason string `json:"reason,omitempty" example:"damaged in warehouse"`
+}
+
+type VariantInput struct {
+	ProductID         string              `json:"productId"               example:"TSHIRT-M-RED" doc:"Unique SKU for the variant"`
+	Name              string              `json:"name,omitempty"          example:"T-Shirt (M, Red)" doc:"Defaults to the parent's name"`
+	Options           models.OptionValues `json:"options"                 example:"{\"size\":\"M\",\"colour\":\"Red\"}"`
+	PriceOverride     *float64            `json:"priceOverride,omitempty" example:"24.99" deprecated:"true" doc:"Use unitPriceOverride"`
+	Quantity          int32               `json:"quantity"                example:"20"`
+	UnitPriceOverride *int64              `json:"unitPriceOverride,omitempty" example:"2499" doc:"Minor units of the parent's currency; omit to follow the parent's price"`
+}
+
+type VariantUpdateInput struct {
+	Options           models.OptionValues `json:"options"                 example:"{\"size\":\"L\",\"colour\":\"Red\"}"`
+	PriceOverride     *float64            `json:"priceOverride,omitempty" example:"24.99" deprecated:"true" doc:"Use unitPriceOverride"`
+	UnitPriceOverride *int64              `json:"unitPriceOverride,omitempty" example:"2499" doc:"Minor units of the parent's currency; omit to follow the parent's price again"`
+}
+
+type BundleComponentInput struct {
+	ProductID string `json:"productId" example:"PROD-004" doc:"A SKU that holds stock; not a bundle or a parent with variants"`
+	Quantity  int32  `json:"quantity"  example:"1" minimum:"1" doc:"Units in one bundle"`
+}
+
+type BundleInput struct {
+	Components []BundleComponentInput `json:"components" doc:"Replaces the bundle's components; empty makes the product an ordinary one again"`
 }
 
 type ReserveInput struct {
-	OrderID   string `json:"orderId"   example:"ORD-12345"`
-	ProductID string `json:"productId" example:"PROD-001"`
-	Quantity  int32  `json:"quantity"  example:"2"`
+	OrderID    string `json:"orderId"              example:"ORD-12345"`
+	ProductID  string `json:"productId"            example:"PROD-001"`
+	Quantity   int32  `json:"quantity"             example:"2"`
+	TTLSeconds int32  `json:"ttlSeconds,omitempty" example:"900" doc:"Reservation lifetime in seconds; omit for the service default"`
 }
 
 // --- Huma Request Wrappers ---
@@ -31,13 +82,305 @@ type ProductIDParam struct {
 	ID string `path:"id" example:"PROD-001" doc:"The unique identifier of the product"`
 }
 
+// ListProductsParams are the query parameters shared by the product listing endpoints.
+type ListProductsParams struct {
+	PageSize      int     `query:"pageSize"  minimum:"0" maximum:"200" doc:"Products per page; 0 uses the default of 50"`
+	PageToken     string  `query:"pageToken" doc:"X-Next-Page-Token from the previous page"`
+	SortBy        string  `query:"sortBy"    enum:"productId,name,price,quantity,updatedAt" default:"productId"`
+	Order         string  `query:"order"     enum:"asc,desc" default:"asc"`
+	MinPrice      float64 `query:"minPrice"  minimum:"0" deprecated:"true" doc:"Use minPriceMinor"`
+	MaxPrice      float64 `query:"maxPrice"  minimum:"0" deprecated:"true" doc:"Use maxPriceMinor"`
+	MinPriceMinor int64   `query:"minPriceMinor" minimum:"0" doc:"Lowest unit price in minor units; 0 means no bound"`
+	MaxPriceMinor int64   `query:"maxPriceMinor" minimum:"0" doc:"Highest unit price in minor units; 0 means no bound"`
+	PriceParams
+
+	// Attribute filters, read from the remaining query parameters by Resolve
+	Attributes []models.AttributeFilter
+}
+
+// PriceParams pick the currency or price list product prices are returned in. Price filters
+// and sorting always use stored catalog prices: a scheduled price running now, a list price or
+// a conversion changes the price returned but not which products match or their order.
+type PriceParams struct {
+	Currency  string `query:"currency"  example:"EUR" doc:"Return prices in this currency, from its oldest price list or by plain conversion"`
+	PriceList string `query:"priceList" example:"eu-retail" doc:"Return prices from this price list"`
+}
+
+type ListActiveProductsRequest struct {
+	ListProductsParams
+	InStock  bool   `query:"inStock"  doc:"Only products with available stock"`
+	Category string `query:"category" example:"keyboards" doc:"Only products in this category or its subcategories"`
+	Grouped  bool   `query:"groupVariants" doc:"List top-level products only, each with its variants nested"`
+}
+
+type SearchProductsRequest struct {
+	Query     string `query:"q"         required:"true" minLength:"1" example:"wireless keyboard" doc:"Free text; quoted phrases, or and -exclusions are honoured"`
+	PageSize  int    `query:"pageSize"  minimum:"0" maximum:"200" doc:"Results per page; 0 uses the default of 50"`
+	PageToken string `query:"pageToken" doc:"X-Next-Page-Token from the previous page"`
+	PriceParams
+}
+
+type GetProductRequest struct {
+	ProductIDParam
+	PriceParams
+}
+
+type CategoryInput struct {
+	ID       string `json:"id"                 example:"keyboards" doc:"Unique ID for the category"`
+	Name     string `json:"name"               example:"Keyboards"`
+	ParentID string `json:"parentId,omitempty" example:"peripherals" doc:"Parent category; omit for a root category"`
+}
+
+type CategoryUpdateInput struct {
+	Name     string `json:"name"               example:"Keyboards"`
+	ParentID string `json:"parentId,omitempty" example:"peripherals" doc:"New parent category; omit to make it a root category"`
+}
+
+type CategoryIDParam struct {
+	CategoryID string `path:"categoryId" example:"keyboards"`
+}
+
+type CreateCategoryRequest struct {
+	Body CategoryInput
+}
+
+type UpdateCategoryRequest struct {
+	CategoryIDParam
+	Body CategoryUpdateInput
+}
+
+type ListCategoryProductsRequest struct {
+	CategoryIDParam
+	ListProductsParams
+	InStock bool `query:"inStock" doc:"Only products with available stock"`
+}
+
+type AttributeDefinitionUpdateInput struct {
+	Name       string   `json:"name"                 example:"Screen size"`
+	Type       string   `json:"type"                 enum:"STRING,NUMBER,BOOLEAN,ENUM"`
+	EnumValues []string `json:"enumValues,omitempty" example:"[\"usb\",\"bluetooth\"]" doc:"The allowed values of an ENUM attribute"`
+	Unit       string   `json:"unit,omitempty"       example:"in"`
+	CategoryID string   `json:"categoryId,omitempty" example:"monitors" doc:"Applies to this category's subtree; omit to apply to every product"`
+}
+
+type AttributeDefinitionInput struct {
+	Key string `json:"key" example:"size_in" doc:"snake_case; used as the filter name in product listings"`
+	AttributeDefinitionUpdateInput
+}
+
+type AttributeKeyParam struct {
+	Key string `path:"key" example:"size_in"`
+}
+
+type ListAttributeDefinitionsRequest struct {
+	Category string `query:"category" example:"monitors" doc:"Only the definitions that apply to this category's products"`
+}
+
+type CreateAttributeDefinitionRequest struct {
+	Body AttributeDefinitionInput
+}
+
+type UpdateAttributeDefinitionRequest struct {
+	AttributeKeyParam
+	Body AttributeDefinitionUpdateInput
+}
+
+type ProductCategoriesInput struct {
+	CategoryIDs []string `json:"categoryIds" example:"[\"keyboards\",\"wireless\"]" doc:"Replaces every existing assignment"`
+}
+
+type SetProductCategoriesRequest struct {
+	ProductIDParam
+	Body ProductCategoriesInput
+}
+
+type CreateVariantRequest struct {
+	ProductIDParam
+	Body VariantInput
+}
+
+type SetBundleComponentsRequest struct {
+	ProductIDParam
+	Body BundleInput
+}
+
+type UpdateVariantRequest struct {
+	ProductIDParam
+	VariantID string `path:"variantId" example:"TSHIRT-M-RED"`
+	Body      VariantUpdateInput
+}
+
 type CreateProductRequest struct {
 	Body ProductInput
 }
 
 type UpdateProductRequest struct {
-	ID   string `path:"id"`
-	Body ProductUpdateInput
+	ID      string `path:"id"`
+	IfMatch string `header:"If-Match" doc:"ETag from GET; required unless the body carries the version"`
+	Body    ProductUpdateInput
+}
+
+type PatchProductRequest struct {
+	ID      string `path:"id"`
+	IfMatch string `header:"If-Match" doc:"ETag from GET; required unless the body carries the version"`
+	Body    ProductPatchInput
+}
+
+type AdjustStockRequest struct {
+	ProductIDParam
+	Body StockAdjustmentInput
+}
+
+type PriceListInput struct {
+	ID                string `json:"id"                          example:"eu-retail" doc:"Unique ID for the price list"`
+	Name              string `json:"name"                        example:"EU retail"`
+	Currency          string `json:"currency"                    example:"EUR" doc:"ISO 4217 code; cannot change later"`
+	Rounding          string `json:"rounding,omitempty"          enum:"HALF_UP,UP,DOWN" default:"HALF_UP" doc:"How converted prices are rounded"`
+	RoundingIncrement int64  `json:"roundingIncrement,omitempty" example:"5" minimum:"0" doc:"Converted prices are rounded to a multiple of this many minor units; 0 means 1"`
+}
+
+type PriceListUpdateInput struct {
+	Name              string `json:"name"                        example:"EU retail"`
+	Rounding          string `json:"rounding,omitempty"          enum:"HALF_UP,UP,DOWN" default:"HALF_UP"`
+	RoundingIncrement int64  `json:"roundingIncrement,omitempty" example:"5" minimum:"0"`
+}
+
+type PriceListIDParam struct {
+	PriceListID string `path:"priceListId" example:"eu-retail"`
+}
+
+type CreatePriceListRequest struct {
+	Body PriceListInput
+}
+
+type UpdatePriceListRequest struct {
+	PriceListIDParam
+	Body PriceListUpdateInput
+}
+
+type ListPriceInput struct {
+	MinorUnits int64 `json:"minorUnits" example:"119900" minimum:"0" doc:"Price in minor units of the price list's currency"`
+}
+
+type ListPriceParams struct {
+	PriceListIDParam
+	ProductID string `path:"productId" example:"PROD-001"`
+}
+
+type SetListPriceRequest struct {
+	ListPriceParams
+	Body ListPriceInput
+}
+
+type ExchangeRateInput struct {
+	Rate string `json:"rate" example:"0.9215" doc:"Units of the quote currency one unit of the base currency buys"`
+}
+
+type CurrencyPairParams struct {
+	Base  string `path:"base"  example:"USD"`
+	Quote string `path:"quote" example:"EUR"`
+}
+
+type SetExchangeRateRequest struct {
+	CurrencyPairParams
+	Body ExchangeRateInput
+}
+
+type PromotionConditionInput struct {
+	Attribute string   `json:"attribute"        enum:"price,quantity,productId,categoryId"`
+	Operator  string   `json:"operator"         enum:"LT,LTE,GT,GTE,EQ,IN"`
+	Value     int64    `json:"value,omitempty"  example:"5000" doc:"Compared with price (minor units) or quantity"`
+	Values    []string `json:"values,omitempty" example:"[\"audio\"]" doc:"Product or category IDs; EQ takes exactly one"`
+}
+
+type PromotionRuleInput struct {
+	Match      string                    `json:"match,omitempty" enum:"ALL,ANY" default:"ALL" doc:"Whether a product must meet all conditions or any one"`
+	Conditions []PromotionConditionInput `json:"conditions"      doc:"No conditions selects every product"`
+}
+
+type PromotionUpdateInput struct {
+	Name             string             `json:"name"                       example:"Black Friday"`
+	Rule             PromotionRuleInput `json:"rule"`
+	DiscountType     string             `json:"discountType"               enum:"PERCENT,FIXED"`
+	DiscountValue    int64              `json:"discountValue"              example:"20" minimum:"0" doc:"Whole percent, or minor units of discountCurrency"`
+	DiscountCurrency string             `json:"discountCurrency,omitempty" example:"USD" doc:"FIXED only; products priced in other currencies are not discounted"`
+	StartsAt         *time.Time         `json:"startsAt,omitempty"         doc:"Omit to start immediately"`
+	EndsAt           *time.Time         `json:"endsAt,omitempty"           doc:"Exclusive; omit to never expire"`
+	Priority         int32              `json:"priority,omitempty"         example:"10" doc:"Where several promotions select a product, the highest priority applies"`
+}
+
+type PromotionInput struct {
+	ID string `json:"id" example:"black-friday" doc:"Unique ID for the promotion"`
+	PromotionUpdateInput
+}
+
+type PromotionIDParam struct {
+	PromotionID string `path:"promotionId" example:"black-friday"`
+}
+
+type CreatePromotionRequest struct {
+	Body PromotionInput
+}
+
+type UpdatePromotionRequest struct {
+	PromotionIDParam
+	Body PromotionUpdateInput
+}
+
+type PriceScheduleInput struct {
+	UnitPrice models.Money `json:"unitPrice" doc:"An empty currency means the product's own"`
+	StartsAt  time.Time    `json:"startsAt"  example:"2026-11-27T00:00:00Z"`
+	EndsAt    time.Time    `json:"endsAt"    example:"2026-12-01T00:00:00Z" doc:"Exclusive; must be after startsAt and in the future"`
+}
+
+type CreatePriceScheduleRequest struct {
+	ProductIDParam
+	Body PriceScheduleInput
+}
+
+type ListPriceSchedulesRequest struct {
+	ProductIDParam
+	IncludeEnded bool `query:"includeEnded" doc:"Also list schedules that are over"`
+}
+
+type PriceScheduleParams struct {
+	ProductIDParam
+	ScheduleID int64 `path:"scheduleId" example:"42"`
+}
+
+type UploadImageRequest struct {
+	ProductIDParam
+	RawBody huma.MultipartFormFiles[struct {
+		Image huma.FormFile `form:"image" contentType:"image/jpeg,image/png,image/gif,image/webp" required:"true" doc:"At most 10 MB and 40 megapixels"`
+	}]
+}
+
+type ReorderImagesRequest struct {
+	ProductIDParam
+	Body struct {
+		ImageIDs []int64 `json:"imageIds" doc:"Every image of the product, main image first"`
+	}
+}
+
+type ImageParams struct {
+	ProductIDParam
+	ImageID int64 `path:"imageId" example:"42"`
+}
+
+type StartImportRequest struct {
+	Format  string `query:"format" enum:"csv,json" doc:"File format; taken from the file name's extension when omitted"`
+	DryRun  bool   `query:"dryRun" doc:"Check every row as a real import would without saving anything"`
+	RawBody huma.MultipartFormFiles[struct {
+		File huma.FormFile `form:"file" required:"true" doc:"CSV with a header row, or a JSON array of products; at most 32 MB"`
+	}]
+}
+
+type ImportJobParams struct {
+	JobID int64 `path:"jobId" example:"42"`
+}
+
+type ExportCatalogRequest struct {
+	Format string `query:"format" enum:"csv,json" default:"csv"`
 }
 
 type AdminDeleteRequest struct {
@@ -48,6 +391,35 @@ type ReserveRequest struct {
 	Body ReserveInput
 }
 
+type BatchItemInput struct {
+	ProductID string `json:"productId" example:"PROD-001"`
+	Quantity  int32  `json:"quantity"  example:"2"`
+}
+
+type BatchReserveInput struct {
+	OrderID      string           `json:"orderId"                example:"ORD-12345"`
+	Items        []BatchItemInput `json:"items"`
+	TTLSeconds   int32            `json:"ttlSeconds,omitempty"   example:"900" doc:"Reservation lifetime in seconds; omit for the service default"`
+	AllowPartial bool             `json:"allowPartial,omitempty" doc:"Grant what is available per line instead of all-or-nothing"`
+}
+
+type BatchReserveRequest struct {
+	Body BatchReserveInput
+}
+
+type BatchReleaseInput struct {
+	OrderID string           `json:"orderId" example:"ORD-12345"`
+	Items   []BatchItemInput `json:"items"`
+}
+
+type BatchReleaseRequest struct {
+	Body BatchReleaseInput
+}
+
+type OrderIDParam struct {
+	OrderID string `path:"orderId" example:"ORD-12345" doc:"The order that holds the reservation"`
+}
+
 type RestockItemsInput struct {
 	ProductID string `json:"productId" example:"PROD-001"`
 	Quantity  int32  `json:"quantity"  example:"10"`
@@ -68,10 +440,149 @@ type SuccessResponse struct {
 	Body SuccessBody
 }
 
+type BatchItemResultBody struct {
+	ProductID string `json:"productId" example:"PROD-003"`
+	Status    string `json:"status"    example:"INSUFFICIENT" enum:"OK,NOT_FOUND,INSUFFICIENT,INACTIVE,HAS_VARIANTS"`
+	Requested int32  `json:"requestedQuantity" example:"20"`
+	Granted   int32  `json:"grantedQuantity"   example:"0"`
+	Available int32  `json:"availableQuantity" example:"15"`
+}
+
+type BatchBody struct {
+	Success bool                  `json:"success" example:"false"`
+	Message string                `json:"message" example:"insufficient stock for product PROD-003"`
+	Items   []BatchItemResultBody `json:"items"`
+}
+
+type BatchResponse struct {
+	Body BatchBody
+}
+
 type ListProductsResponse struct {
+	NextPageToken string `header:"X-Next-Page-Token" doc:"Pass as pageToken to fetch the next page; absent on the last page"`
+	Body          []models.ProductStock
+}
+
+type ProductResponse struct {
+	ETag string `header:"ETag" doc:"Send back as If-Match when updating"`
+	Body models.ProductStock
+}
+
+type ListVariantsResponse struct {
 	Body []models.ProductStock
 }
 
+type SearchHitBody struct {
+	models.ProductStock
+	Rank float64 `json:"rank" example:"0.83" doc:"Relevance; higher is better"`
+}
+
+type SearchProductsResponse struct {
+	NextPageToken string `header:"X-Next-Page-Token" doc:"Pass as pageToken to fetch the next page; absent on the last page"`
+	Body          []SearchHitBody
+}
+
+type CategoryResponse struct {
+	Body models.Category
+}
+
+type ListCategoriesResponse struct {
+	Body []models.Category
+}
+
+type PriceListResponse struct {
+	Body models.PriceList
+}
+
+type ListPriceListsResponse struct {
+	Body []models.PriceList
+}
+
+type PriceListEntryResponse struct {
+	Body models.PriceListEntry
+}
+
+type ExchangeRateResponse struct {
+	Body models.ExchangeRate
+}
+
+type ListExchangeRatesResponse struct {
+	Body []models.ExchangeRate
+}
+
+type PriceScheduleResponse struct {
+	Body models.PriceSchedule
+}
+
+type ListPriceSchedulesResponse struct {
+	Body []models.PriceSchedule
+}
+
+type ImageResponse struct {
+	Body models.ProductImage
+}
+
+type ListImagesResponse struct {
+	Body []models.ProductImage
+}
+
+type AttributeDefinitionResponse struct {
+	Body models.AttributeDefinition
+}
+
+type ListAttributeDefinitionsResponse struct {
+	Body []models.AttributeDefinition
+}
+
+type ImportJobResponse struct {
+	Body models.ImportJob
+}
+
+type PromotionResponse struct {
+	Body models.Promotion
+}
+
+type ListPromotionsResponse struct {
+	Body []models.Promotion
+}
+
+// OfferBody is a product as listed elsewhere plus the promotion that discounts it.
+type OfferBody struct {
+	models.ProductStock
+	OriginalPrice   models.Money     `json:"originalPrice"`
+	DiscountedPrice models.Money     `json:"discountedPrice"`
+	Promotion       models.Promotion `json:"promotion"`
+}
+
+type ListOffersResponse struct {
+	Body []OfferBody
+}
+
+type ReservationLineBody struct {
+	ProductID   string       `json:"productId"             example:"PROD-001"`
+	Quantity    int32        `json:"quantity"              example:"2"`
+	Status      string       `json:"status"                example:"PENDING" enum:"PENDING,CONFIRMED,RELEASED"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+	ExpiresAt   time.Time    `json:"expiresAt"`
+	ConfirmedAt *time.Time   `json:"confirmedAt,omitempty"`
+	ReleasedAt  *time.Time   `json:"releasedAt,omitempty"`
+	UnitPrice   models.Money `json:"unitPrice"             doc:"Price in effect when reserved, scheduled prices included"`
+}
+
+type ReservationBody struct {
+	OrderID   string                `json:"orderId"   example:"ORD-12345"`
+	Status    string                `json:"status"    example:"PENDING" enum:"PENDING,CONFIRMED,RELEASED"`
+	Lines     []ReservationLineBody `json:"lines"`
+	CreatedAt time.Time             `json:"createdAt"`
+	UpdatedAt time.Time             `json:"updatedAt"`
+	ExpiresAt time.Time             `json:"expiresAt"`
+}
+
+type ReservationResponse struct {
+	Body ReservationBody
+}
+
 type StockBody struct {
 	ProductID string `json:"productId" example:"PROD-001"`
 	Quantity  int32  `json:"quantity"  example:"50"`
@@ -80,3 +591,164 @@ type StockBody struct {
 type StockResponse struct {
 	Body StockBody
 }
+
+var productSortParams = map[string]models.ProductSort{
+	"productId": models.SortByProductID,
+	"name":      models.SortByName,
+	"price":     models.SortByPrice,
+	"quantity":  models.SortByQuantity,
+	"updatedAt": models.SortByUpdatedAt,
+}
+
+func (p ListProductsParams) toQuery() models.ProductQuery {
+	return models.ProductQuery{
+		PageSize:   p.PageSize,
+		PageToken:  p.PageToken,
+		SortBy:     productSortParams[p.SortBy],
+		Descending: p.Order == "desc",
+		MinPrice:   minorUnitsOrDeprecated(p.MinPriceMinor, p.MinPrice),
+		MaxPrice:   minorUnitsOrDeprecated(p.MaxPriceMinor, p.MaxPrice),
+		Attributes: p.Attributes,
+	}
+}
+
+// priceOrDeprecated prefers the exact price and falls back to the deprecated decimal one,
+// which is read in the default currency.
+func priceOrDeprecated(price *models.Money, deprecated float64) models.Money {
+	if price != nil {
+		return *price
+	}
+	return models.MoneyFromFloat(deprecated, models.DefaultCurrency)
+}
+
+func (in ProductPatchInput) price() *models.Money {
+	if in.UnitPrice == nil && in.Price == nil {
+		return nil
+	}
+	var deprecated float64
+	if in.Price != nil {
+		deprecated = *in.Price
+	}
+	price := priceOrDeprecated(in.UnitPrice, deprecated)
+	return &price
+}
+
+// priceOverrideOrDeprecated is priceOrDeprecated for variant overrides, which are in minor
+// units of the parent's currency.
+func priceOverrideOrDeprecated(minorUnits *int64, deprecated *float64) *int64 {
+	if minorUnits != nil || deprecated == nil {
+		return minorUnits
+	}
+	override := models.MoneyFromFloat(*deprecated, models.DefaultCurrency).MinorUnits
+	return &override
+}
+
+// minorUnitsOrDeprecated is priceOrDeprecated for price filters, where zero means unset.
+func minorUnitsOrDeprecated(minorUnits int64, deprecated float64) int64 {
+	if minorUnits == 0 && deprecated != 0 {
+		return models.MoneyFromFloat(deprecated, models.DefaultCurrency).MinorUnits
+	}
+	return minorUnits
+}
+
+func (p PriceParams) toSelector() models.PriceSelector {
+	return models.PriceSelector{PriceListID: p.PriceList, Currency: p.Currency}
+}
+
+func (in PriceListInput) toPriceList() models.PriceList {
+	return models.PriceList{
+		ID:                in.ID,
+		Name:              in.Name,
+		Currency:          in.Currency,
+		Rounding:          models.RoundingMode(in.Rounding),
+		RoundingIncrement: in.RoundingIncrement,
+	}
+}
+
+func (in PromotionUpdateInput) toPromotion(promotionID string) models.Promotion {
+	conditions := make([]models.PromotionCondition, 0, len(in.Rule.Conditions))
+	for _, condition := range in.Rule.Conditions {
+		conditions = append(conditions, models.PromotionCondition{
+			Attribute: models.RuleAttribute(condition.Attribute),
+			Operator:  models.RuleOperator(condition.Operator),
+			Value:     condition.Value,
+			Values:    condition.Values,
+		})
+	}
+	return models.Promotion{
+		ID:               promotionID,
+		Name:             in.Name,
+		Rule:             models.PromotionRule{Match: models.RuleMatch(in.Rule.Match), Conditions: conditions},
+		DiscountType:     models.DiscountType(in.DiscountType),
+		DiscountValue:    in.DiscountValue,
+		DiscountCurrency: in.DiscountCurrency,
+		StartsAt:         in.StartsAt,
+		EndsAt:           in.EndsAt,
+		Priority:         in.Priority,
+	}
+}
+
+func toOfferBodies(offers []models.Offer) []OfferBody {
+	bodies := make([]OfferBody, 0, len(offers))
+	for _, offer := range offers {
+		bodies = append(bodies, OfferBody{
+			ProductStock:    offer.Product,
+			OriginalPrice:   offer.OriginalPrice,
+			DiscountedPrice: offer.DiscountedPrice,
+			Promotion:       offer.Promotion,
+		})
+	}
+	return bodies
+}
+
+func toReservationBody(summary models.ReservationSummary) ReservationBody {
+	body := ReservationBody{
+		OrderID:   summary.OrderID,
+		Status:    string(summary.Status),
+		Lines:     []ReservationLineBody{},
+		CreatedAt: summary.CreatedAt,
+		UpdatedAt: summary.UpdatedAt,
+		ExpiresAt: summary.ExpiresAt,
+	}
+	for _, line := range summary.Lines {
+		body.Lines = append(body.Lines, ReservationLineBody{
+			ProductID:   line.ProductID,
+			Quantity:    line.Quantity,
+			Status:      string(line.Status),
+			CreatedAt:   line.CreatedAt,
+			UpdatedAt:   line.UpdatedAt,
+			ExpiresAt:   line.ExpiresAt,
+			ConfirmedAt: line.ConfirmedAt,
+			ReleasedAt:  line.ReleasedAt,
+			UnitPrice:   line.UnitPrice,
+		})
+	}
+	return body
+}
+
+func toBatchItems(inputs []BatchItemInput) []models.BatchItem {
+	items := make([]models.BatchItem, 0, len(inputs))
+	for _, input := range inputs {
+		items = append(items, models.BatchItem{ProductID: input.ProductID, Quantity: input.Quantity})
+	}
+	return items
+}
+
+func toBatchBody(success bool, message string, results []models.BatchItemResult) BatchBody {
+	body := BatchBody{Success: success, Message: message, Items: []BatchItemResultBody{}}
+	for _, result := range results {
+		body.Items = append(body.Items, BatchItemResultBody{
+			ProductID: result.ProductID,
+			Status:    string(result.Status),
+			Requested: result.Requested,
+			Granted:   result.Granted,
+			Available: result.Available,
+		})
+	}
+	return body
+}
+
+func toProductResponse(product models.ProductStock) *ProductResponse {
+	// Strong, so it can be sent back as If-Match, which only matches strong tags
+	return &ProductResponse{ETag: fmt.Sprintf(`"%d"`, product.Version), Body: product}
+}
@@ -10,11 +10,16 @@ import (
 )
 
 type InventoryHandler struct {
-	svc service.InventoryService
+	svc        service.InventoryService
+	categories service.CategoryService
+	pricing    service.PricingService
+	promotions service.PromotionService
+	media      service.MediaService
+	catalog    service.CatalogService
 }
 
-func NewInventoryHandler(svc service.InventoryService) *InventoryHandler {
-	return &InventoryHandler{svc: svc}
+func NewInventoryHandler(svc service.InventoryService, categories service.CategoryService, pricing service.PricingService, promotions service.PromotionService, media service.MediaService, catalog service.CatalogService) *InventoryHandler {
+	return &InventoryHandler{svc: svc, categories: categories, pricing: pricing, promotions: promotions, media: media, catalog: catalog}
 }
 
 func (h *InventoryHandler) SetupRoutes(r *gin.Engine) {
@@ -27,9 +32,17 @@ func (h *InventoryHandler) SetupRoutes(r *gin.Engine) {
 
 	// 2. Register all handlers
 	RegisterHealthHandler(api)
-	RegisterInventoryHandlers(api, h.svc)
+	RegisterInventoryHandlers(api, h.svc, h.pricing, h.promotions)
 	RegisterSystemHandlers(api, h.svc)
 	RegisterAdminHandlers(api, h.svc)
+	RegisterVariantHandlers(api, h.svc)
+	RegisterBundleHandlers(api, h.svc)
+	RegisterCategoryHandlers(api, h.categories, h.svc, h.pricing)
+	RegisterAttributeHandlers(api, h.categories)
+	RegisterPricingHandlers(api, h.pricing)
+	RegisterPromotionHandlers(api, h.promotions)
+	RegisterMediaHandlers(api, h.media)
+	RegisterCatalogHandlers(api, h.catalog)
 
 	// 3. Add Scalar UI route manually to Gin
 	r.GET("/docs", h.ScalarUI)
@@ -2,26 +2,29 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
 	"inventory-service/internal/service"
 	"net/http"
 	"github.com/danielgtaylor/huma/v2"
 )
 
-func RegisterInventoryHandlers(api huma.API, svc service.InventoryService) {
+func RegisterInventoryHandlers(api huma.API, svc service.InventoryService, pricing service.PricingService, promotions service.PromotionService) {
 	// List all products
 	huma.Register(api, huma.Operation{
 		OperationID: "list-products",
 		Method:      http.MethodGet,
 		Path:        "/api/inventory/active-products",
 		Summary:     "List all products",
+		Description: attributeFiltersDoc,
 		Tags:        []string{"Inventory"},
-	}, func(ctx context.Context, input *struct{}) (*ListProductsResponse, error) {
-		products, err := svc.ListProducts(ctx)
-		if err != nil {
-			return nil, huma.Error500InternalServerError(err.Error())
-		}
-		return &ListProductsResponse{Body: products}, nil
+	}, func(ctx context.Context, input *ListActiveProductsRequest) (*ListProductsResponse, error) {
+		query := input.toQuery()
+		query.InStockOnly = input.InStock
+		query.CategoryID = input.Category
+		query.GroupVariants = input.Grouped
+		return listProducts(ctx, svc, pricing, query, input.toSelector())
 	})
 
         // List product offers
@@ -30,13 +33,14 @@ func RegisterInventoryHandlers(api huma.API, svc service.InventoryService) {
                 Method:      http.MethodGet,
                 Path:        "/api/inventory/offer",
                 Summary:     "List product offers",
+                Description: "Products discounted by the active promotions, each with its original and discounted price and the promotion that applied.",
                 Tags:        []string{"Inventory"},
-        }, func(ctx context.Context, input *struct{}) (*ListProductsResponse, error) {
-		products, err := svc.GetOffers(ctx)
+        }, func(ctx context.Context, input *struct{}) (*ListOffersResponse, error) {
+		offers, err := promotions.ListOffers(ctx)
 		if err != nil {
 			return nil, huma.Error500InternalServerError(err.Error())
 		}
-		return &ListProductsResponse{Body: products}, nil
+		return &ListOffersResponse{Body: toOfferBodies(offers)}, nil
 	})
 
 	// List stock-out products (PROTECTED)
@@ -46,21 +50,41 @@ func RegisterInventoryHandlers(api huma.API, svc service.InventoryService) {
 		Path:        "/api/inventory/stock-out",
 		Summary:     "List out-of-stock products",
 		Tags:        []string{"Inventory"},
-	}, func(ctx context.Context, input *struct{}) (*ListProductsResponse, error) {
-		products, err := svc.ListProducts(ctx)
+	}, func(ctx context.Context, input *ListProductsParams) (*ListProductsResponse, error) {
+		query := input.toQuery()
+		query.OutOfStockOnly = true
+		return listProducts(ctx, svc, pricing, query, input.toSelector())
+	})
+
+	// Search the catalog
+	huma.Register(api, huma.Operation{
+		OperationID: "search-products",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/search",
+		Summary:     "Search products",
+		Description: "Ranked full-text search over product names and descriptions, tolerant of typos in names.",
+		Tags:        []string{"Inventory"},
+	}, func(ctx context.Context, input *SearchProductsRequest) (*SearchProductsResponse, error) {
+		page, err := svc.SearchProducts(ctx, models.SearchQuery{Query: input.Query, PageSize: input.PageSize, PageToken: input.PageToken})
 		if err != nil {
+			if errors.Is(err, repository.ErrEmptySearchQuery) || errors.Is(err, repository.ErrInvalidPageToken) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
 			return nil, huma.Error500InternalServerError(err.Error())
 		}
-		
-		// Filter for stock-out only
-		var stockOut []models.ProductStock
-		for _, p := range products {
-			if p.Quantity <= 0 {
-				stockOut = append(stockOut, p)
-			}
+		products := make([]models.ProductStock, len(page.Hits))
+		for i, hit := range page.Hits {
+			products[i] = hit.ProductStock
+		}
+		products, err = pricing.PriceProducts(ctx, input.toSelector(), products)
+		if err != nil {
+			return nil, toPricingHTTPError(err)
 		}
-		
-		return &ListProductsResponse{Body: stockOut}, nil
+		hits := []SearchHitBody{}
+		for i, hit := range page.Hits {
+			hits = append(hits, SearchHitBody{ProductStock: products[i], Rank: hit.Rank})
+		}
+		return &SearchProductsResponse{NextPageToken: page.NextPageToken, Body: hits}, nil
 	})
 
 	// Get a specific product by ID
@@ -70,11 +94,34 @@ func RegisterInventoryHandlers(api huma.API, svc service.InventoryService) {
 		Path:        "/api/inventory/active-products/{id}",
 		Summary:     "Get product details",
 		Tags:        []string{"Inventory"},
-	}, func(ctx context.Context, input *ProductIDParam) (*struct{ Body models.ProductStock }, error) {
+	}, func(ctx context.Context, input *GetProductRequest) (*ProductResponse, error) {
 		product, err := svc.GetProduct(ctx, input.ID)
 		if err != nil {
 			return nil, huma.Error404NotFound("Product not found")
 		}
-		return &struct{ Body models.ProductStock }{Body: product}, nil
+		priced, err := pricing.PriceProducts(ctx, input.toSelector(), []models.ProductStock{product})
+		if err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return toProductResponse(priced[0]), nil
 	})
 }
+
+func listProducts(ctx context.Context, svc service.InventoryService, pricing service.PricingService, query models.ProductQuery, selector models.PriceSelector) (*ListProductsResponse, error) {
+	page, err := svc.ListProducts(ctx, query)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidPageToken) || errors.Is(err, repository.ErrInvalidSort) ||
+			errors.Is(err, repository.ErrInvalidAttributeFilter) {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+	products, err := pricing.PriceProducts(ctx, selector, page.Products)
+	if err != nil {
+		return nil, toPricingHTTPError(err)
+	}
+	if products == nil {
+		products = []models.ProductStock{}
+	}
+	return &ListProductsResponse{NextPageToken: page.NextPageToken, Body: products}, nil
+}
@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"io"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterMediaHandlers(api huma.API, media service.MediaService) {
+	// List a product's images in display order
+	huma.Register(api, huma.Operation{
+		OperationID: "list-product-images",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/active-products/{id}/images",
+		Summary:     "List product images",
+		Tags:        []string{"Media"},
+	}, func(ctx context.Context, input *ProductIDParam) (*ListImagesResponse, error) {
+		images, err := media.ListImages(ctx, input.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		if images == nil {
+			images = []models.ProductImage{}
+		}
+		return &ListImagesResponse{Body: images}, nil
+	})
+
+	// Upload an image; it is added after the product's other images (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "upload-product-image",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/active-products/{id}/images",
+		Summary:       "Upload product image",
+		Description:   "The picture is stored as a thumbnail of at most 320 pixels and a detail image of at most 1600 pixels on the longest edge, both JPEG.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusCreated,
+		MaxBodyBytes:  service.MaxImageBytes + 1<<20, // Room for the multipart envelope
+	}, func(ctx context.Context, input *UploadImageRequest) (*ImageResponse, error) {
+		file := input.RawBody.Data().Image
+		data, err := io.ReadAll(io.LimitReader(file, service.MaxImageBytes+1))
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		image, err := media.UploadImage(ctx, input.ID, data)
+		if err != nil {
+			return nil, toMediaHTTPError(err)
+		}
+		return &ImageResponse{Body: image}, nil
+	})
+
+	// Put a product's images in a new order (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "reorder-product-images",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/active-products/{id}/images/order",
+		Summary:     "Reorder product images",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *ReorderImagesRequest) (*ListImagesResponse, error) {
+		images, err := media.ReorderImages(ctx, input.ID, input.Body.ImageIDs)
+		if err != nil {
+			return nil, toMediaHTTPError(err)
+		}
+		return &ListImagesResponse{Body: images}, nil
+	})
+
+	// Delete an image and its files (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-product-image",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/active-products/{id}/images/{imageId}",
+		Summary:     "Delete product image",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *ImageParams) (*SuccessResponse, error) {
+		if err := media.DeleteImage(ctx, input.ID, input.ImageID); err != nil {
+			return nil, toMediaHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Image deleted successfully"},
+		}, nil
+	})
+}
+
+func toMediaHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidImage), errors.Is(err, repository.ErrImageOrderMismatch):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, repository.ErrProductNotFound), errors.Is(err, repository.ErrImageNotFound):
+		return huma.Error404NotFound(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
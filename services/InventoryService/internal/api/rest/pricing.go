@@ -0,0 +1,261 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterPricingHandlers(api huma.API, pricing service.PricingService) {
+	// List price lists
+	huma.Register(api, huma.Operation{
+		OperationID: "list-price-lists",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/price-lists",
+		Summary:     "List price lists",
+		Tags:        []string{"Pricing"},
+	}, func(ctx context.Context, input *struct{}) (*ListPriceListsResponse, error) {
+		lists, err := pricing.ListPriceLists(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		if lists == nil {
+			lists = []models.PriceList{}
+		}
+		return &ListPriceListsResponse{Body: lists}, nil
+	})
+
+	// Get a single price list
+	huma.Register(api, huma.Operation{
+		OperationID: "get-price-list",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/price-lists/{priceListId}",
+		Summary:     "Get price list",
+		Tags:        []string{"Pricing"},
+	}, func(ctx context.Context, input *PriceListIDParam) (*PriceListResponse, error) {
+		list, err := pricing.GetPriceList(ctx, input.PriceListID)
+		if err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &PriceListResponse{Body: list}, nil
+	})
+
+	// Create a price list (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "create-price-list",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/price-lists",
+		Summary:       "Create price list",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusCreated,
+	}, func(ctx context.Context, input *CreatePriceListRequest) (*PriceListResponse, error) {
+		list, err := pricing.CreatePriceList(ctx, input.Body.toPriceList())
+		if err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &PriceListResponse{Body: list}, nil
+	})
+
+	// Rename a price list or change its rounding (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "update-price-list",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/price-lists/{priceListId}",
+		Summary:     "Update price list",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *UpdatePriceListRequest) (*PriceListResponse, error) {
+		list, err := pricing.UpdatePriceList(ctx, models.PriceList{
+			ID:                input.PriceListID,
+			Name:              input.Body.Name,
+			Rounding:          models.RoundingMode(input.Body.Rounding),
+			RoundingIncrement: input.Body.RoundingIncrement,
+		})
+		if err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &PriceListResponse{Body: list}, nil
+	})
+
+	// Delete a price list and its explicit prices (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-price-list",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/price-lists/{priceListId}",
+		Summary:     "Delete price list",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *PriceListIDParam) (*SuccessResponse, error) {
+		if err := pricing.DeletePriceList(ctx, input.PriceListID); err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Price list deleted successfully"},
+		}, nil
+	})
+
+	// Set a product's explicit price in a price list (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "set-list-price",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/price-lists/{priceListId}/prices/{productId}",
+		Summary:     "Set list price",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *SetListPriceRequest) (*PriceListEntryResponse, error) {
+		entry, err := pricing.SetPrice(ctx, input.PriceListID, input.ProductID, input.Body.MinorUnits)
+		if err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &PriceListEntryResponse{Body: entry}, nil
+	})
+
+	// Drop a product's explicit price so it is converted again (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-list-price",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/price-lists/{priceListId}/prices/{productId}",
+		Summary:     "Delete list price",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *ListPriceParams) (*SuccessResponse, error) {
+		if err := pricing.DeletePrice(ctx, input.PriceListID, input.ProductID); err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "List price deleted successfully"},
+		}, nil
+	})
+
+	// List exchange rates
+	huma.Register(api, huma.Operation{
+		OperationID: "list-exchange-rates",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/exchange-rates",
+		Summary:     "List exchange rates",
+		Tags:        []string{"Pricing"},
+	}, func(ctx context.Context, input *struct{}) (*ListExchangeRatesResponse, error) {
+		rates, err := pricing.ListExchangeRates(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		if rates == nil {
+			rates = []models.ExchangeRate{}
+		}
+		return &ListExchangeRatesResponse{Body: rates}, nil
+	})
+
+	// Set the rate of a currency pair (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "set-exchange-rate",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/exchange-rates/{base}/{quote}",
+		Summary:     "Set exchange rate",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *SetExchangeRateRequest) (*ExchangeRateResponse, error) {
+		rate, err := pricing.SetExchangeRate(ctx, input.Base, input.Quote, input.Body.Rate)
+		if err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &ExchangeRateResponse{Body: rate}, nil
+	})
+
+	// Delete the rate of a currency pair (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-exchange-rate",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/exchange-rates/{base}/{quote}",
+		Summary:     "Delete exchange rate",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *CurrencyPairParams) (*SuccessResponse, error) {
+		if err := pricing.DeleteExchangeRate(ctx, input.Base, input.Quote); err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Exchange rate deleted successfully"},
+		}, nil
+	})
+
+	// List a product's price schedules
+	huma.Register(api, huma.Operation{
+		OperationID: "list-price-schedules",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/active-products/{id}/price-schedules",
+		Summary:     "List price schedules",
+		Tags:        []string{"Pricing"},
+	}, func(ctx context.Context, input *ListPriceSchedulesRequest) (*ListPriceSchedulesResponse, error) {
+		schedules, err := pricing.ListPriceSchedules(ctx, input.ID, input.IncludeEnded)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		if schedules == nil {
+			schedules = []models.PriceSchedule{}
+		}
+		return &ListPriceSchedulesResponse{Body: schedules}, nil
+	})
+
+	// Queue a price change for a time window (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "create-price-schedule",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/active-products/{id}/price-schedules",
+		Summary:       "Schedule price",
+		Description:   "The product sells at the scheduled price from startsAt until endsAt, in reads and reservations alike. Variants without a price override follow their parent's schedules.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusCreated,
+	}, func(ctx context.Context, input *CreatePriceScheduleRequest) (*PriceScheduleResponse, error) {
+		schedule, err := pricing.CreatePriceSchedule(ctx, models.PriceSchedule{
+			ProductID: input.ID,
+			UnitPrice: input.Body.UnitPrice,
+			StartsAt:  input.Body.StartsAt,
+			EndsAt:    input.Body.EndsAt,
+		})
+		if err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &PriceScheduleResponse{Body: schedule}, nil
+	})
+
+	// Cancel a price schedule; a running one ends at once (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "cancel-price-schedule",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/active-products/{id}/price-schedules/{scheduleId}",
+		Summary:     "Cancel price schedule",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *PriceScheduleParams) (*SuccessResponse, error) {
+		if err := pricing.CancelPriceSchedule(ctx, input.ID, input.ScheduleID); err != nil {
+			return nil, toPricingHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Price schedule cancelled successfully"},
+		}, nil
+	})
+}
+
+func toPricingHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPriceList),
+		errors.Is(err, service.ErrInvalidExchangeRate),
+		errors.Is(err, service.ErrInvalidCurrency),
+		errors.Is(err, service.ErrInvalidPrice),
+		errors.Is(err, service.ErrPriceListCurrency),
+		errors.Is(err, service.ErrInvalidPriceSchedule):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, repository.ErrPriceListNotFound),
+		errors.Is(err, repository.ErrPriceListEntryNotFound),
+		errors.Is(err, repository.ErrExchangeRateNotFound),
+		errors.Is(err, repository.ErrProductNotFound),
+		errors.Is(err, repository.ErrPriceScheduleNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, repository.ErrPriceListExists),
+		errors.Is(err, repository.ErrPriceScheduleOverlap),
+		errors.Is(err, repository.ErrPriceScheduleCurrency):
+		return huma.Error409Conflict(err.Error())
+	case errors.Is(err, service.ErrNoExchangeRate):
+		return huma.Error422UnprocessableEntity(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
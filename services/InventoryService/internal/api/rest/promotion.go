@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterPromotionHandlers(api huma.API, promotions service.PromotionService) {
+	// List promotions, expired and scheduled ones included
+	huma.Register(api, huma.Operation{
+		OperationID: "list-promotions",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/promotions",
+		Summary:     "List promotions",
+		Tags:        []string{"Promotions"},
+	}, func(ctx context.Context, input *struct{}) (*ListPromotionsResponse, error) {
+		list, err := promotions.ListPromotions(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		if list == nil {
+			list = []models.Promotion{}
+		}
+		return &ListPromotionsResponse{Body: list}, nil
+	})
+
+	// Get a single promotion
+	huma.Register(api, huma.Operation{
+		OperationID: "get-promotion",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/promotions/{promotionId}",
+		Summary:     "Get promotion",
+		Tags:        []string{"Promotions"},
+	}, func(ctx context.Context, input *PromotionIDParam) (*PromotionResponse, error) {
+		promotion, err := promotions.GetPromotion(ctx, input.PromotionID)
+		if err != nil {
+			return nil, toPromotionHTTPError(err)
+		}
+		return &PromotionResponse{Body: promotion}, nil
+	})
+
+	// Create a promotion (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "create-promotion",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/promotions",
+		Summary:       "Create promotion",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusCreated,
+	}, func(ctx context.Context, input *CreatePromotionRequest) (*PromotionResponse, error) {
+		promotion, err := promotions.CreatePromotion(ctx, input.Body.toPromotion(input.Body.ID))
+		if err != nil {
+			return nil, toPromotionHTTPError(err)
+		}
+		return &PromotionResponse{Body: promotion}, nil
+	})
+
+	// Replace a promotion's rule, discount, window or priority (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "update-promotion",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/promotions/{promotionId}",
+		Summary:     "Update promotion",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *UpdatePromotionRequest) (*PromotionResponse, error) {
+		promotion, err := promotions.UpdatePromotion(ctx, input.Body.toPromotion(input.PromotionID))
+		if err != nil {
+			return nil, toPromotionHTTPError(err)
+		}
+		return &PromotionResponse{Body: promotion}, nil
+	})
+
+	// Delete a promotion (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-promotion",
+		Method:      http.MethodDelete,
+		Path:        "/api/inventory/promotions/{promotionId}",
+		Summary:     "Delete promotion",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *PromotionIDParam) (*SuccessResponse, error) {
+		if err := promotions.DeletePromotion(ctx, input.PromotionID); err != nil {
+			return nil, toPromotionHTTPError(err)
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: true, Message: "Promotion deleted successfully"},
+		}, nil
+	})
+}
+
+func toPromotionHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPromotion):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, repository.ErrPromotionNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, repository.ErrPromotionExists):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
@@ -2,8 +2,12 @@ package rest
 
 import (
 	"context"
+	"errors"
+	"inventory-service/internal/repository"
 	"inventory-service/internal/service"
 	"net/http"
+	"time"
+
 	"github.com/danielgtaylor/huma/v2"
 )
 
@@ -16,7 +20,102 @@ func RegisterSystemHandlers(api huma.API, svc service.InventoryService) {
 		Summary:     "Reserve stock",
 		Tags:        []string{"System"},
 	}, func(ctx context.Context, input *ReserveRequest) (*SuccessResponse, error) {
-		success, msg, err := svc.Reserve(ctx, input.Body.OrderID, input.Body.ProductID, input.Body.Quantity)
+		success, msg, err := svc.Reserve(ctx, input.Body.OrderID, input.Body.ProductID, input.Body.Quantity, time.Duration(input.Body.TTLSeconds)*time.Second)
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrIdempotencyConflict):
+				return nil, huma.Error409Conflict(err.Error())
+			case errors.Is(err, repository.ErrInvalidQuantity):
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: success, Message: msg},
+		}, nil
+	})
+
+	// Reserve several products for an order in one transaction
+	huma.Register(api, huma.Operation{
+		OperationID: "batch-reserve-stock",
+		Method:      http.MethodPost,
+		Path:        "/api/inventory/reserve/batch",
+		Summary:     "Batch reserve stock",
+		Tags:        []string{"System"},
+	}, func(ctx context.Context, input *BatchReserveRequest) (*BatchResponse, error) {
+		ttl := time.Duration(input.Body.TTLSeconds) * time.Second
+		success, msg, results, err := svc.BatchReserve(ctx, input.Body.OrderID, toBatchItems(input.Body.Items), ttl, input.Body.AllowPartial)
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrIdempotencyConflict):
+				return nil, huma.Error409Conflict(err.Error())
+			case errors.Is(err, repository.ErrInvalidQuantity):
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		return &BatchResponse{Body: toBatchBody(success, msg, results)}, nil
+	})
+
+	// Release everything an order holds
+	huma.Register(api, huma.Operation{
+		OperationID: "batch-release-stock",
+		Method:      http.MethodPost,
+		Path:        "/api/inventory/release/batch",
+		Summary:     "Batch release stock",
+		Tags:        []string{"System"},
+	}, func(ctx context.Context, input *BatchReleaseRequest) (*BatchResponse, error) {
+		success, msg, results, err := svc.BatchRelease(ctx, input.Body.OrderID, toBatchItems(input.Body.Items))
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		return &BatchResponse{Body: toBatchBody(success, msg, results)}, nil
+	})
+
+	// Inspect what an order currently holds
+	huma.Register(api, huma.Operation{
+		OperationID: "get-reservation",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/reservations/{orderId}",
+		Summary:     "Get reservation",
+		Tags:        []string{"System"},
+	}, func(ctx context.Context, input *OrderIDParam) (*ReservationResponse, error) {
+		summary, err := svc.GetReservation(ctx, input.OrderID)
+		if err != nil {
+			if errors.Is(err, repository.ErrReservationNotFound) {
+				return nil, huma.Error404NotFound("Reservation not found")
+			}
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		return &ReservationResponse{Body: toReservationBody(summary)}, nil
+	})
+
+	// Confirm a pending reservation (saga commit)
+	huma.Register(api, huma.Operation{
+		OperationID: "confirm-reservation",
+		Method:      http.MethodPost,
+		Path:        "/api/inventory/reservations/{orderId}/confirm",
+		Summary:     "Confirm reservation",
+		Tags:        []string{"System"},
+	}, func(ctx context.Context, input *OrderIDParam) (*SuccessResponse, error) {
+		success, msg, err := svc.ConfirmReservation(ctx, input.OrderID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		return &SuccessResponse{
+			Body: SuccessBody{Success: success, Message: msg},
+		}, nil
+	})
+
+	// Cancel a pending reservation (saga compensation)
+	huma.Register(api, huma.Operation{
+		OperationID: "cancel-reservation",
+		Method:      http.MethodPost,
+		Path:        "/api/inventory/reservations/{orderId}/cancel",
+		Summary:     "Cancel reservation",
+		Tags:        []string{"System"},
+	}, func(ctx context.Context, input *OrderIDParam) (*SuccessResponse, error) {
+		success, msg, err := svc.CancelReservation(ctx, input.OrderID)
 		if err != nil {
 			return nil, huma.Error500InternalServerError(err.Error())
 		}
@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterVariantHandlers(api huma.API, svc service.InventoryService) {
+	// List the variants of a product
+	huma.Register(api, huma.Operation{
+		OperationID: "list-variants",
+		Method:      http.MethodGet,
+		Path:        "/api/inventory/active-products/{id}/variants",
+		Summary:     "List product variants",
+		Tags:        []string{"Inventory"},
+	}, func(ctx context.Context, input *ProductIDParam) (*ListVariantsResponse, error) {
+		variants, err := svc.ListVariants(ctx, input.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+		if variants == nil {
+			variants = []models.ProductStock{}
+		}
+		return &ListVariantsResponse{Body: variants}, nil
+	})
+
+	// Add a variant SKU to a product (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID:   "create-variant",
+		Method:        http.MethodPost,
+		Path:          "/api/inventory/active-products/{id}/variants",
+		Summary:       "Create product variant",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusCreated,
+	}, func(ctx context.Context, input *CreateVariantRequest) (*ProductResponse, error) {
+		variant, err := svc.CreateVariant(ctx, input.ID, input.Body.ProductID, input.Body.Name, input.Body.Options, priceOverrideOrDeprecated(input.Body.UnitPriceOverride, input.Body.PriceOverride), input.Body.Quantity)
+		if err != nil {
+			return nil, toVariantHTTPError(err)
+		}
+		return toProductResponse(variant), nil
+	})
+
+	// Change a variant's options or price override (PROTECTED)
+	huma.Register(api, huma.Operation{
+		OperationID: "update-variant",
+		Method:      http.MethodPut,
+		Path:        "/api/inventory/active-products/{id}/variants/{variantId}",
+		Summary:     "Update product variant",
+		Tags:        []string{"Admin"},
+	}, func(ctx context.Context, input *UpdateVariantRequest) (*ProductResponse, error) {
+		existing, err := svc.GetProduct(ctx, input.VariantID)
+		if err != nil || existing.ParentID == nil || *existing.ParentID != input.ID {
+			return nil, huma.Error404NotFound("Variant not found")
+		}
+		variant, err := svc.UpdateVariant(ctx, input.VariantID, input.Body.Options, priceOverrideOrDeprecated(input.Body.UnitPriceOverride, input.Body.PriceOverride))
+		if err != nil {
+			return nil, toVariantHTTPError(err)
+		}
+		return toProductResponse(variant), nil
+	})
+}
+
+func toVariantHTTPError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidVariant), errors.Is(err, service.ErrInvalidPrice):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, repository.ErrProductNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, repository.ErrDuplicateVariant),
+		errors.Is(err, repository.ErrNestedVariant),
+		errors.Is(err, repository.ErrNotAVariant),
+		errors.Is(err, repository.ErrParentHasStock),
+		errors.Is(err, repository.ErrProductIsBundle),
+		errors.Is(err, repository.ErrProductArchived):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
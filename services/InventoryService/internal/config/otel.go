@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -10,26 +11,31 @@ import (
 	"go.opentelemetry.io/contrib/bridges/otelslog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 )
 
-func InitTracer() (*sdktrace.TracerProvider, error) {
+// InitTracer installs the global trace, meter and log providers and returns a function that
+// flushes and shuts all of them down. Without an OTLP endpoint nothing is installed and the
+// returned function does nothing.
+func InitTracer() (func(context.Context) error, error) {
 	ctx := context.Background()
 
 	// Check ONLY for the global environment variable
 	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
 	if endpoint == "" {
 		fmt.Println("[OBSERVABILITY] ⚠️ OTEL_EXPORTER_OTLP_ENDPOINT not found. Tracing is DISABLED.")
-		return nil, nil
+		return func(context.Context) error { return nil }, nil
 	}
 
-	fmt.Printf("[OBSERVABILITY] ✅ Unified OTLP Enabled (Traces & Logs). Exporting to: %s (Auto-Config)\n", endpoint)
+	fmt.Printf("[OBSERVABILITY] ✅ Unified OTLP Enabled (Traces, Metrics & Logs). Exporting to: %s (Auto-Config)\n", endpoint)
 
 	// 1. Trace Exporter
 	traceExporter, err := otlptracegrpc.New(ctx)
@@ -37,7 +43,13 @@ func InitTracer() (*sdktrace.TracerProvider, error) {
 		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
 	}
 
-	// 2. Log Exporter
+	// 2. Metric Exporter
+	metricExporter, err := otlpmetricgrpc.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metric exporter: %w", err)
+	}
+
+	// 3. Log Exporter
 	logExporter, err := otlploggrpc.New(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create log exporter: %w", err)
@@ -52,7 +64,7 @@ func InitTracer() (*sdktrace.TracerProvider, error) {
 		return nil, fmt.Errorf("failed to create resource: %w", err)
 	}
 
-	// 3. Trace Provider
+	// 4. Trace Provider
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithSampler(sdktrace.AlwaysSample()),
 		sdktrace.WithBatcher(traceExporter, sdktrace.WithBatchTimeout(time.Second)),
@@ -61,17 +73,27 @@ func InitTracer() (*sdktrace.TracerProvider, error) {
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 
-	// 4. Log Provider
+	// 5. Meter Provider
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+	)
+	otel.SetMeterProvider(mp)
+
+	// 6. Log Provider
 	lp := log.NewLoggerProvider(
 		log.WithResource(res),
 		log.WithProcessor(log.NewBatchProcessor(logExporter)),
 	)
 	global.SetLoggerProvider(lp)
 
-	// 5. Bridge slog to OTel
+	// 7. Bridge slog to OTel
 	// We use the OTel slog handler so slog.Info() goes to OTLP
 	logger := otelslog.NewLogger(os.Getenv("SERVICE_NAME"))
 	slog.SetDefault(logger)
 
-	return tp, nil
+	shutdown := func(ctx context.Context) error {
+		return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx), lp.Shutdown(ctx))
+	}
+	return shutdown, nil
 }
@@ -0,0 +1,12 @@
+package database
+
+import (
+	"gorm.io/gorm"
+)
+
+// migrateAttributes indexes product attribute values for the containment queries that
+// equality filters compile to.
+func migrateAttributes(db *gorm.DB) error {
+	return db.Exec(`CREATE INDEX IF NOT EXISTS idx_product_stocks_attributes
+		ON product_stocks USING GIN (attributes jsonb_path_ops)`).Error
+}
@@ -17,11 +17,31 @@ func InitDB(host, user, password, dbname, port string) *gorm.DB {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
+	// The hard-coded offers become a promotion the first time promotions are migrated
+	seedOffers := !db.Migrator().HasTable(&models.Promotion{})
+
 	// Auto Migration
-	err = db.AutoMigrate(&models.ProductStock{}, &models.IdempotencyRecord{})
+	err = db.AutoMigrate(&models.ProductStock{}, &models.IdempotencyRecord{}, &models.Reservation{}, &models.Category{}, &models.ProductCategory{},
+		&models.PriceList{}, &models.PriceListEntry{}, &models.ExchangeRate{}, &models.Promotion{}, &models.PriceSchedule{}, &models.ProductImage{}, &models.AttributeDefinition{}, &models.ImportJob{},
+		&models.BundleComponent{}, &models.ReservedComponent{})
 	if err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
+	if err := migrateSearch(db); err != nil {
+		log.Fatalf("Failed to migrate search index: %v", err)
+	}
+	if err := migrateAttributes(db); err != nil {
+		log.Fatalf("Failed to migrate attribute index: %v", err)
+	}
+	if err := migrateMoney(db); err != nil {
+		log.Fatalf("Failed to backfill prices: %v", err)
+	}
+
+	if seedOffers {
+		if err := seedLegacyOffers(db); err != nil {
+			log.Fatalf("Failed to seed offers promotion: %v", err)
+		}
+	}
 
 	SeedDatabase(db)
 
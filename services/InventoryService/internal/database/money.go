@@ -0,0 +1,23 @@
+package database
+
+import (
+	"gorm.io/gorm"
+)
+
+// migrateMoney backfills the minor-unit price columns from the deprecated decimal ones for
+// rows written before prices were exact. Every such row is priced in the default currency,
+// whose minor unit is a hundredth.
+func migrateMoney(db *gorm.DB) error {
+	statements := []string{
+		`UPDATE product_stocks SET price_minor_units = ROUND(price * 100)
+			WHERE price_minor_units = 0 AND price <> 0`,
+		`UPDATE product_stocks SET price_override_minor_units = ROUND(price_override * 100)
+			WHERE price_override_minor_units IS NULL AND price_override IS NOT NULL`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
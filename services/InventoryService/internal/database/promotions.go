@@ -0,0 +1,23 @@
+package database
+
+import (
+	"inventory-service/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// seedLegacyOffers stores the rule /offer used to hard-code, products under 50.00 or with
+// fewer than 10 in stock, as a promotion without a discount so the storefront keeps showing
+// the same offers until it is edited.
+func seedLegacyOffers(db *gorm.DB) error {
+	return db.Create(&models.Promotion{
+		ID:   "legacy-offers",
+		Name: "Offers",
+		Rule: models.PromotionRule{Match: models.MatchAny, Conditions: []models.PromotionCondition{
+			{Attribute: models.RulePrice, Operator: models.OpLessThan, Value: 5000},
+			{Attribute: models.RuleQuantity, Operator: models.OpLessThan, Value: 10},
+		}},
+		DiscountType:  models.DiscountPercent,
+		DiscountValue: 0,
+	}).Error
+}
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"gorm.io/gorm"
+)
+
+// migrateSearch adds what catalog search needs beyond the gorm models: a weighted tsvector
+// that Postgres keeps in sync with name (weight A) and description (weight B), and a
+// trigram index on name for typo-tolerant matches.
+func migrateSearch(db *gorm.DB) error {
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`ALTER TABLE product_stocks ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+				setweight(to_tsvector('english', coalesce(description, '')), 'B')
+			) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_product_stocks_search ON product_stocks USING GIN (search_vector)`,
+		`CREATE INDEX IF NOT EXISTS idx_product_stocks_name_trgm ON product_stocks USING GIN (name gin_trgm_ops)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -19,54 +19,65 @@ func SeedDatabase(db *gorm.DB) {
 
 	products := []models.ProductStock{
 		{
-			ProductID: "PROD-001",
-			Name:      "High-Performance Laptop",
-			Price:     1299.99,
-			Quantity:  50,
+			ProductID:   "PROD-001",
+			Name:        "High-Performance Laptop",
+			Description: "15-inch notebook with a 12-core CPU, 32GB RAM and 1TB SSD",
+			UnitPrice:   models.Money{MinorUnits: 129999, Currency: "USD"},
+			Quantity:    50,
 		},
 		{
-			ProductID: "PROD-002",
-			Name:      "Wireless Noise-Cancelling Headphones",
-			Price:     299.50,
-			Quantity:  120,
+			ProductID:   "PROD-002",
+			Name:        "Wireless Noise-Cancelling Headphones",
+			Description: "Over-ear Bluetooth headphones with active noise cancellation and 30-hour battery",
+			UnitPrice:   models.Money{MinorUnits: 29950, Currency: "USD"},
+			Quantity:    120,
 		},
 		{
-			ProductID: "PROD-003",
-			Name:      "Ergonomic Mechanical Keyboard",
-			Price:     159.00,
-			Quantity:  15, // Low stock for testing
+			ProductID:   "PROD-003",
+			Name:        "Ergonomic Mechanical Keyboard",
+			Description: "Split mechanical keyboard with hot-swappable switches and wrist rest",
+			UnitPrice:   models.Money{MinorUnits: 15900, Currency: "USD"},
+			Quantity:    15, // Low stock for testing
 		},
 		{
-			ProductID: "PROD-004",
-			Name:      "4K Ultra HD Monitor",
-			Price:     449.99,
-			Quantity:  0, // Out of stock for testing
+			ProductID:   "PROD-004",
+			Name:        "4K Ultra HD Monitor",
+			Description: "27-inch IPS display with HDR10 and USB-C power delivery",
+			UnitPrice:   models.Money{MinorUnits: 44999, Currency: "USD"},
+			Quantity:    0, // Out of stock for testing
 		},
 		{
-			ProductID: "PROD-005",
-			Name:      "Basic USB Optical Mouse",
-			Price:     12.99, // Cheap (Offer)
-			Quantity:  200,
+			ProductID:   "PROD-005",
+			Name:        "Basic USB Optical Mouse",
+			Description: "Wired three-button mouse for everyday office use",
+			UnitPrice:   models.Money{MinorUnits: 1299, Currency: "USD"}, // Cheap (Offer)
+			Quantity:    200,
 		},
 		{
-			ProductID: "PROD-006",
-			Name:      "Professional Gaming Mouse",
-			Price:     89.00,
-			Quantity:  5, // Low stock (Offer)
+			ProductID:   "PROD-006",
+			Name:        "Professional Gaming Mouse",
+			Description: "Lightweight wireless mouse with a 26K DPI sensor and programmable buttons",
+			UnitPrice:   models.Money{MinorUnits: 8900, Currency: "USD"},
+			Quantity:    5, // Low stock (Offer)
 		},
 		{
-			ProductID: "PROD-007",
-			Name:      "Bluetooth Multi-Device Keyboard",
-			Price:     45.00, // Cheap (Offer)
-			Quantity:  8,  // Low stock (Offer)
+			ProductID:   "PROD-007",
+			Name:        "Bluetooth Multi-Device Keyboard",
+			Description: "Compact keyboard that pairs with up to three devices",
+			UnitPrice:   models.Money{MinorUnits: 4500, Currency: "USD"}, // Cheap (Offer)
+			Quantity:    8,                                               // Low stock (Offer)
 		},
 		{
-			ProductID: "PROD-008",
-			Name:      "USB-C Docking Station",
-			Price:     129.99,
-			Quantity:  40,
+			ProductID:   "PROD-008",
+			Name:        "USB-C Docking Station",
+			Description: "Thunderbolt-compatible dock with dual HDMI, Ethernet and 100W charging",
+			UnitPrice:   models.Money{MinorUnits: 12999, Currency: "USD"},
+			Quantity:    40,
 		},
 	}
+	for i := range products {
+		products[i].SetUnitPrice(products[i].UnitPrice) // Fill the deprecated decimal price
+	}
 
 	if err := db.Create(&products).Error; err != nil {
 		log.Printf("❌ Failed to seed inventory: %v", err)
@@ -0,0 +1,153 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type AttributeType string
+
+const (
+	AttributeString  AttributeType = "STRING"
+	AttributeNumber  AttributeType = "NUMBER"
+	AttributeBoolean AttributeType = "BOOLEAN"
+	AttributeEnum    AttributeType = "ENUM" // One of EnumValues
+)
+
+// AttributeDefinition declares a product attribute such as screen size or connectivity. A
+// definition attached to a category applies to the products in that category and its
+// subcategories; one without a category applies to every product. Keys are unique across
+// the catalog so filters can name them on their own.
+type AttributeDefinition struct {
+	Key        string        `gorm:"primaryKey;size:64" json:"key"`
+	Name       string        `gorm:"size:255;not null" json:"name"`
+	Type       AttributeType `gorm:"size:16;not null" json:"type"`
+	EnumValues StringList    `gorm:"type:jsonb" json:"enumValues,omitempty"`
+	Unit       string        `gorm:"size:32;not null;default:''" json:"unit,omitempty"` // e.g. "in" for screen sizes
+	CategoryID *string       `gorm:"size:255;index" json:"categoryId,omitempty"`
+	CreatedAt  time.Time     `json:"createdAt"`
+	UpdatedAt  time.Time     `json:"updatedAt"`
+	Category   *Category     `gorm:"foreignKey:CategoryID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// Check reports why value, as decoded from JSON, is not a valid value of the attribute.
+func (d AttributeDefinition) Check(value interface{}) error {
+	switch d.Type {
+	case AttributeString:
+		if _, ok := value.(string); ok {
+			return nil
+		}
+		return fmt.Errorf("%s must be a string", d.Key)
+	case AttributeNumber:
+		if number, ok := value.(float64); ok && !math.IsInf(number, 0) && !math.IsNaN(number) {
+			return nil
+		}
+		return fmt.Errorf("%s must be a number", d.Key)
+	case AttributeBoolean:
+		if _, ok := value.(bool); ok {
+			return nil
+		}
+		return fmt.Errorf("%s must be true or false", d.Key)
+	case AttributeEnum:
+		if s, ok := value.(string); ok && slices.Contains(d.EnumValues, s) {
+			return nil
+		}
+		return fmt.Errorf("%s must be one of %v", d.Key, []string(d.EnumValues))
+	}
+	return fmt.Errorf("%s has an unknown type %q", d.Key, d.Type)
+}
+
+// ParseValue reads a filter operand written as text into a value of the attribute.
+func (d AttributeDefinition) ParseValue(raw string) (interface{}, error) {
+	var value interface{} = raw
+	switch d.Type {
+	case AttributeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number", d.Key)
+		}
+		value = number
+	case AttributeBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false", d.Key)
+		}
+		value = b
+	}
+	return value, d.Check(value)
+}
+
+// AttributeFilter keeps the products whose attribute Key compares to Value as Operator says.
+// Only numbers can be ordered; other types support EQ and NE. NE also keeps products without
+// the attribute.
+type AttributeFilter struct {
+	Key      string
+	Operator RuleOperator
+	Value    string
+}
+
+// AttributeValues are a product's attribute values keyed by attribute key, as decoded from
+// JSON. They are stored as JSONB.
+type AttributeValues map[string]interface{}
+
+func (a AttributeValues) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(map[string]interface{}(a))
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (a *AttributeValues) Scan(value interface{}) error {
+	raw, err := jsonBytes(value)
+	if raw == nil || err != nil {
+		*a = nil
+		return err
+	}
+	return json.Unmarshal(raw, (*map[string]interface{})(a))
+}
+
+// StringList is a list of strings stored as JSONB.
+type StringList []string
+
+func (l StringList) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal([]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (l *StringList) Scan(value interface{}) error {
+	raw, err := jsonBytes(value)
+	if raw == nil || err != nil {
+		*l = nil
+		return err
+	}
+	return json.Unmarshal(raw, (*[]string)(l))
+}
+
+// jsonBytes reads a JSONB column as scanned by the driver; NULL gives nil.
+func jsonBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("cannot scan %T as JSON", value)
+	}
+}
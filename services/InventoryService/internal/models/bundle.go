@@ -0,0 +1,22 @@
+package models
+
+// BundleComponent is one line of a bundle's definition: every unit of the bundle is made of
+// Quantity units of the component. A bundle is a sellable product with components; it holds
+// no stock of its own and is available as often as its scarcest component allows. Components
+// are SKUs that hold stock, never bundles or parents with variants.
+type BundleComponent struct {
+	BundleID    string       `gorm:"primaryKey;size:255" json:"-"`
+	ComponentID string       `gorm:"primaryKey;size:255;index" json:"productId"`
+	Quantity    int32        `gorm:"not null" json:"quantity"`
+	Bundle      ProductStock `gorm:"foreignKey:BundleID;constraint:OnDelete:CASCADE" json:"-"`
+	Component   ProductStock `gorm:"foreignKey:ComponentID;constraint:OnDelete:RESTRICT" json:"-"`
+}
+
+// ReservedComponent is the stock of one component held by a bundle's ledger line. It is fixed
+// when the bundle is reserved, so releases return exactly this even if the bundle changes later.
+type ReservedComponent struct {
+	OrderID     string `gorm:"primaryKey;size:255"`
+	BundleID    string `gorm:"primaryKey;size:255"`
+	ComponentID string `gorm:"primaryKey;size:255;index"`
+	Quantity    int32  `gorm:"not null"` // Units held for the whole line
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// CatalogFormat is the file format of a catalog import or export.
+type CatalogFormat string
+
+const (
+	CatalogCSV  CatalogFormat = "csv"
+	CatalogJSON CatalogFormat = "json"
+)
+
+// CatalogRecord is one product as imported and exported in bulk. Records are upserted by
+// ProductID: a new product is created and an existing one has its catalog fields replaced.
+// Fields left nil keep their current value, or their default for a new product.
+type CatalogRecord struct {
+	ProductID   string            `json:"productId"`
+	ParentID    *string           `json:"parentId,omitempty"` // Set for variants; the parent must exist or come earlier in the file
+	Name        string            `json:"name,omitempty"`     // Required unless the record is a variant
+	Description *string           `json:"description,omitempty"`
+	UnitPrice   *Money            `json:"unitPrice,omitempty"` // Required unless the record is a variant, for which it is the price override; without one a variant follows its parent's price
+	Quantity    int32             `json:"quantity"`            // Initial stock of a new product; stock of existing products only changes through adjustments
+	Options     OptionValues      `json:"options,omitempty"`   // Variants only
+	Categories  []string          `json:"categories"`          // Replaces the product's categories
+	Attributes  AttributeValues   `json:"attributes"`          // Replaces the product's attribute values
+	Components  []BundleComponent `json:"components"`          // Replaces the product's bundle components; an empty list makes it an ordinary product
+}
+
+// UpsertOutcome says what an upsert did with one record.
+type UpsertOutcome string
+
+const (
+	UpsertCreated UpsertOutcome = "CREATED"
+	UpsertUpdated UpsertOutcome = "UPDATED"
+	UpsertFailed  UpsertOutcome = "FAILED"
+)
+
+// UpsertResult is the outcome of upserting one catalog record. Message says why it failed.
+type UpsertResult struct {
+	ProductID string
+	Outcome   UpsertOutcome
+	Message   string
+}
+
+// ImportStatus tracks a catalog import job from upload to completion.
+type ImportStatus string
+
+const (
+	ImportPending   ImportStatus = "PENDING"
+	ImportRunning   ImportStatus = "RUNNING"
+	ImportSucceeded ImportStatus = "SUCCEEDED" // Every row was processed; some may have failed
+	ImportFailed    ImportStatus = "FAILED"    // The file could not be read or the job could not finish
+)
+
+// ImportJob is an uploaded catalog file processed in the background. A dry run checks every
+// row exactly as a real import would, then discards the changes.
+type ImportJob struct {
+	ID         int64           `gorm:"primaryKey" json:"id"`
+	Format     CatalogFormat   `gorm:"size:8;not null" json:"format"`
+	DryRun     bool            `gorm:"not null;default:false" json:"dryRun"`
+	Status     ImportStatus    `gorm:"size:16;not null;index" json:"status"`
+	Payload    []byte          `gorm:"type:bytea" json:"-"` // The uploaded file; dropped once the job finishes
+	Total      int             `gorm:"not null;default:0" json:"total"`
+	Processed  int             `gorm:"not null;default:0" json:"processed"`
+	Created    int             `gorm:"not null;default:0" json:"created"`
+	Updated    int             `gorm:"not null;default:0" json:"updated"`
+	Failed     int             `gorm:"not null;default:0" json:"failed"`
+	Errors     ImportRowErrors `gorm:"type:jsonb" json:"errors,omitempty"`                     // The first failed rows; Failed counts them all
+	Message    string          `gorm:"type:text;not null;default:''" json:"message,omitempty"` // Why the job failed as a whole
+	CreatedAt  time.Time       `json:"createdAt"`
+	UpdatedAt  time.Time       `json:"updatedAt"` // Refreshed as rows are processed
+	StartedAt  *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt *time.Time      `json:"finishedAt,omitempty"`
+}
+
+// ImportRowError reports a row that could not be imported. Row counts records from 1, not
+// including the CSV header.
+type ImportRowError struct {
+	Row       int    `json:"row"`
+	ProductID string `json:"productId,omitempty"`
+	Message   string `json:"message"`
+}
+
+// ImportRowErrors are a job's row errors, stored as JSONB.
+type ImportRowErrors []ImportRowError
+
+func (e ImportRowErrors) Value() (driver.Value, error) {
+	if len(e) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal([]ImportRowError(e))
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (e *ImportRowErrors) Scan(value interface{}) error {
+	raw, err := jsonBytes(value)
+	if raw == nil || err != nil {
+		*e = nil
+		return err
+	}
+	return json.Unmarshal(raw, (*[]ImportRowError)(e))
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// Category is a node in the catalog taxonomy. Root categories have no parent.
+type Category struct {
+	ID        string    `gorm:"primaryKey;size:255" json:"id"`
+	ParentID  *string   `gorm:"size:255;index" json:"parentId,omitempty"`
+	Parent    *Category `gorm:"foreignKey:ParentID;constraint:OnDelete:RESTRICT" json:"-"`
+	Name      string    `gorm:"size:255;not null" json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ProductCategory assigns a product to a category. A product may sit in several categories;
+// assignments disappear with either side.
+type ProductCategory struct {
+	ProductID  string       `gorm:"primaryKey;size:255"`
+	CategoryID string       `gorm:"primaryKey;size:255;index"`
+	Product    ProductStock `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE"`
+	Category   Category     `gorm:"foreignKey:CategoryID;constraint:OnDelete:CASCADE"`
+}
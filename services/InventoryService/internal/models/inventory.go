@@ -4,20 +4,161 @@ import (
 	"time"
 )
 
+// ProductStock is one stock-keeping unit. A product sold in several variants is a parent row
+// whose variants are rows of their own pointing at it through ParentID; only rows without
+// variants hold stock that can be reserved. A bundle holds none either: reserving it reserves
+// its components.
 type ProductStock struct {
-	ProductID string    `gorm:"primaryKey;size:255" json:"productId"`
-	Name      string    `gorm:"size:255" json:"name"`
-	Price     float64   `gorm:"type:decimal(10,2)" json:"price"`
-	Quantity  int32     `gorm:"not null" json:"quantity"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ProductID         string            `gorm:"primaryKey;size:255" json:"productId"`
+	ParentID          *string           `gorm:"size:255;index" json:"parentId,omitempty"`
+	Name              string            `gorm:"size:255" json:"name"`
+	Description       string            `gorm:"type:text;not null;default:''" json:"description"`
+	Options           OptionValues      `gorm:"type:jsonb" json:"options,omitempty"`                                  // Variant option values, e.g. size and colour
+	Attributes        AttributeValues   `gorm:"type:jsonb" json:"attributes,omitempty"`                               // Specs such as screen size, checked against their definitions
+	UnitPrice         Money             `gorm:"embedded;embeddedPrefix:price_" json:"unitPrice"`                      // Effective unit price
+	UnitPriceOverride *int64            `gorm:"column:price_override_minor_units" json:"unitPriceOverride,omitempty"` // Variant price in minor units of UnitPrice.Currency; nil follows the parent
+	Price             float64           `gorm:"type:decimal(10,2)" json:"price"`                                      // Deprecated: UnitPrice as a decimal, kept in sync until clients have moved
+	PriceOverride     *float64          `gorm:"type:decimal(10,2)" json:"priceOverride,omitempty"`                    // Deprecated: UnitPriceOverride as a decimal
+	PriceListID       string            `gorm:"-" json:"priceListId,omitempty"`                                       // Set when UnitPrice was read through a price list
+	PriceScheduleID   *int64            `gorm:"-" json:"priceScheduleId,omitempty"`                                   // Set when UnitPrice is a scheduled price
+	Quantity          int32             `gorm:"not null" json:"quantity"`
+	Reserved          int32             `gorm:"not null;default:0" json:"reserved"`
+	UpdatedAt         time.Time         `json:"updatedAt"`
+	Version           int64             `gorm:"not null;default:1" json:"version"`                                          // Bumped by every catalog edit; stock movements leave it alone
+	ArchivedAt        *time.Time        `gorm:"index" json:"archivedAt,omitempty"`                                          // Set when deleted; hidden from listings and cannot be reserved
+	Variants          []ProductStock    `gorm:"foreignKey:ParentID;constraint:OnDelete:RESTRICT" json:"variants,omitempty"` // Only loaded for grouped listings
+	Images            []ProductImage    `gorm:"-" json:"images,omitempty"`                                                  // In display order
+	Components        []BundleComponent `gorm:"-" json:"components,omitempty"`                                              // Set on bundles, whose Quantity is derived from them
 }
 
 type IdempotencyRecord struct {
-	OrderID   string    `gorm:"primaryKey;size:255"`
+	OrderID     string `gorm:"primaryKey;size:255"`
+	RequestHash string `gorm:"size:64"` // SHA-256 of the reserve payload; empty for records written before fingerprints
+	CreatedAt   time.Time
+}
+
+// ReservationStatus tracks a ledger line through the Try-Confirm-Cancel saga.
+type ReservationStatus string
+
+const (
+	ReservationPending   ReservationStatus = "PENDING"   // Held for an order that is not paid yet
+	ReservationConfirmed ReservationStatus = "CONFIRMED" // Permanently deducted (sold)
+	ReservationReleased  ReservationStatus = "RELEASED"  // Returned to available stock
+)
+
+// Reservation is a single ledger line: the quantity of one product held for one order.
+// Releases restore exactly this quantity, whatever the caller claims.
+type Reservation struct {
+	OrderID     string            `gorm:"primaryKey;size:255"`
+	ProductID   string            `gorm:"primaryKey;size:255"`
+	Quantity    int32             `gorm:"not null"` // Granted (and therefore held) quantity
+	Requested   int32             `gorm:"not null;default:0"`
+	UnitPrice   Money             `gorm:"embedded;embeddedPrefix:price_"` // Effective price per unit when reserved; zero for lines reserved before prices were recorded
+	Status      ReservationStatus `gorm:"size:20;not null;default:PENDING;index"`
+	ExpiresAt   time.Time         `gorm:"index"`
+	ConfirmedAt *time.Time
+	ReleasedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ReservationSummary is everything the ledger holds for one order.
+type ReservationSummary struct {
+	OrderID   string
+	Status    ReservationStatus
+	Lines     []Reservation
 	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
 }
 
 type BatchItem struct {
 	ProductID string
 	Quantity  int32
 }
+
+// ItemStatus is the machine-readable outcome of one line of a batch operation.
+type ItemStatus string
+
+const (
+	ItemOK           ItemStatus = "OK"
+	ItemNotFound     ItemStatus = "NOT_FOUND"
+	ItemInsufficient ItemStatus = "INSUFFICIENT"
+	ItemInactive     ItemStatus = "INACTIVE"
+	ItemHasVariants  ItemStatus = "HAS_VARIANTS" // A parent product; only its variant SKUs hold stock
+)
+
+// BatchItemResult reports the outcome of a batch operation for one line. For reservations
+// Granted is what was reserved; for releases it is what was returned to stock.
+type BatchItemResult struct {
+	ProductID string
+	Status    ItemStatus
+	Requested int32
+	Granted   int32
+	Available int32 // Available stock when the line was evaluated
+}
+
+// ProductSort is the column a product listing is ordered by. Ties are always broken by
+// product ID so pages are stable.
+type ProductSort string
+
+const (
+	SortByProductID ProductSort = ""
+	SortByName      ProductSort = "name"
+	SortByPrice     ProductSort = "price"
+	SortByQuantity  ProductSort = "quantity"
+	SortByUpdatedAt ProductSort = "updated_at"
+)
+
+// ProductPatch names the catalog fields to change; nil fields keep their current value.
+// Stock is deliberately absent: quantities only change through adjustments and reservations.
+// Version is the version the editor last read and must still be current. AnyVersion applies
+// the patch to whatever version is current instead, as an If-Match: * precondition does.
+type ProductPatch struct {
+	Version     int64
+	AnyVersion  bool
+	Name        *string
+	Description *string
+	Price       *Money
+	Attributes  AttributeValues // Merged into the product's values; a nil value removes the attribute
+}
+
+// ProductQuery selects one page of a product listing. Zero values mean "no filter".
+type ProductQuery struct {
+	PageSize       int
+	PageToken      string // Opaque cursor from the previous page's NextPageToken
+	SortBy         ProductSort
+	Descending     bool
+	MinPrice       int64 // Minor units of each product's own currency, against its stored price
+	MaxPrice       int64
+	InStockOnly    bool
+	OutOfStockOnly bool
+	CategoryID     string            // Products in this category or any of its subcategories
+	GroupVariants  bool              // Page over top-level products and nest their variants
+	Attributes     []AttributeFilter // All must hold
+}
+
+// ProductPage is one page of a product listing. NextPageToken is empty on the last page.
+type ProductPage struct {
+	Products      []ProductStock
+	NextPageToken string
+}
+
+// SearchQuery selects one page of catalog search results.
+type SearchQuery struct {
+	Query     string
+	PageSize  int
+	PageToken string // Opaque cursor from the previous page's NextPageToken
+}
+
+// SearchHit is a product matching a search, with its relevance. Higher ranks first.
+type SearchHit struct {
+	ProductStock
+	Rank float64
+}
+
+// SearchPage is one page of search results. NextPageToken is empty on the last page.
+type SearchPage struct {
+	Hits          []SearchHit
+	NextPageToken string
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// ProductImage is one uploaded picture of a product, stored as a thumbnail and a detail-size
+// rendition. Images are shown in Position order and disappear with the product.
+type ProductImage struct {
+	ID           int64        `gorm:"primaryKey" json:"id"`
+	ProductID    string       `gorm:"size:255;not null;index" json:"productId"`
+	Position     int32        `gorm:"not null" json:"position"` // 0 is the product's main image
+	ThumbnailURL string       `gorm:"size:1024;not null" json:"thumbnailUrl"`
+	DetailURL    string       `gorm:"size:1024;not null" json:"detailUrl"`
+	ThumbnailKey string       `gorm:"size:512;not null" json:"-"` // Storage keys, for deleting the files
+	DetailKey    string       `gorm:"size:512;not null" json:"-"`
+	Width        int32        `gorm:"not null" json:"width"` // Of the detail rendition
+	Height       int32        `gorm:"not null" json:"height"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	Product      ProductStock `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE" json:"-"`
+}
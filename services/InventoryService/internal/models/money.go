@@ -0,0 +1,75 @@
+package models
+
+import (
+	"math"
+)
+
+// DefaultCurrency prices products created through the deprecated float price fields.
+const DefaultCurrency = "USD"
+
+// Money is an exact amount in the minor unit of its currency, e.g. 1299 USD is $12.99.
+type Money struct {
+	MinorUnits int64  `gorm:"not null;default:0" json:"minorUnits"`
+	Currency   string `gorm:"size:3;not null;default:'USD'" json:"currency"`
+}
+
+// currencyExponents lists the currencies whose minor unit is not a hundredth.
+var currencyExponents = map[string]int{
+	"BHD": 3, "CLP": 0, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "OMR": 3, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+// CurrencyExponent is the number of decimal places of a currency's minor unit.
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// MoneyFromFloat converts a decimal amount to Money, rounding half away from zero to the
+// currency's minor unit. It only exists for the deprecated float price fields.
+func MoneyFromFloat(amount float64, currency string) Money {
+	scale := math.Pow10(CurrencyExponent(currency))
+	return Money{MinorUnits: int64(math.Round(amount * scale)), Currency: currency}
+}
+
+// Float is the amount as a decimal, for the deprecated float price fields only.
+func (m Money) Float() float64 {
+	return float64(m.MinorUnits) / math.Pow10(CurrencyExponent(m.Currency))
+}
+
+// Valid reports whether the amount is not negative and the currency looks like an ISO 4217 code.
+func (m Money) Valid() bool {
+	return m.MinorUnits >= 0 && ValidCurrency(m.Currency)
+}
+
+// ValidCurrency reports whether code looks like an ISO 4217 currency code.
+func ValidCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// SetUnitPrice sets a product's effective price and keeps the deprecated decimal in sync.
+func (p *ProductStock) SetUnitPrice(price Money) {
+	p.UnitPrice = price
+	p.Price = price.Float()
+}
+
+// SetUnitPriceOverride sets a variant's price override in minor units of its currency, or
+// clears it with nil, and keeps the deprecated decimal in sync.
+func (p *ProductStock) SetUnitPriceOverride(minorUnits *int64) {
+	p.UnitPriceOverride = minorUnits
+	p.PriceOverride = nil
+	if minorUnits != nil {
+		override := Money{MinorUnits: *minorUnits, Currency: p.UnitPrice.Currency}.Float()
+		p.PriceOverride = &override
+	}
+}
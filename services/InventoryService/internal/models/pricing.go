@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+)
+
+// RoundingMode says which way a converted price that falls between two allowed amounts goes.
+type RoundingMode string
+
+const (
+	RoundHalfUp RoundingMode = "HALF_UP" // To the nearest amount, halves away from zero
+	RoundUp     RoundingMode = "UP"
+	RoundDown   RoundingMode = "DOWN"
+)
+
+// PriceList prices the catalog in one currency. Products with an entry in the list sell at
+// that price; every other product is converted from its own price through the exchange-rate
+// table and rounded to a multiple of RoundingIncrement minor units.
+type PriceList struct {
+	ID                string       `gorm:"primaryKey;size:255" json:"id"`
+	Name              string       `gorm:"size:255;not null" json:"name"`
+	Currency          string       `gorm:"size:3;not null;index" json:"currency"`
+	Rounding          RoundingMode `gorm:"size:16;not null;default:'HALF_UP'" json:"rounding"`
+	RoundingIncrement int64        `gorm:"not null;default:1" json:"roundingIncrement"`
+	CreatedAt         time.Time    `json:"createdAt"`
+	UpdatedAt         time.Time    `json:"updatedAt"`
+}
+
+// PriceListEntry is an explicit price for one product in a price list, in minor units of the
+// list's currency. Entries disappear with either side.
+type PriceListEntry struct {
+	PriceListID string       `gorm:"primaryKey;size:255" json:"priceListId"`
+	ProductID   string       `gorm:"primaryKey;size:255;index" json:"productId"`
+	MinorUnits  int64        `gorm:"not null" json:"minorUnits"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+	PriceList   PriceList    `gorm:"foreignKey:PriceListID;constraint:OnDelete:CASCADE" json:"-"`
+	Product     ProductStock `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// ExchangeRate says one unit of BaseCurrency buys Rate units of QuoteCurrency. Rate is a
+// decimal string so it is stored and applied exactly. A rate is also used the other way
+// round when the inverse pair has none of its own.
+type ExchangeRate struct {
+	BaseCurrency  string    `gorm:"primaryKey;size:3" json:"baseCurrency"`
+	QuoteCurrency string    `gorm:"primaryKey;size:3" json:"quoteCurrency"`
+	Rate          string    `gorm:"type:numeric(20,10);not null" json:"rate"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// PriceSelector picks the prices a product read is returned in: a named price list, or a
+// currency, which uses that currency's oldest price list or plain conversion when it has none.
+// The zero value keeps catalog prices.
+type PriceSelector struct {
+	PriceListID string
+	Currency    string
+}
+
+// PriceSchedule replaces a product's price from StartsAt until EndsAt, e.g. for a weekend
+// sale. Variants without a price override follow their parent's schedules. A product's
+// schedules never overlap, and they disappear with the product.
+type PriceSchedule struct {
+	ID        int64        `gorm:"primaryKey" json:"id"`
+	ProductID string       `gorm:"size:255;not null;index" json:"productId"`
+	UnitPrice Money        `gorm:"embedded;embeddedPrefix:price_" json:"unitPrice"` // In the product's currency
+	StartsAt  time.Time    `gorm:"not null" json:"startsAt"`
+	EndsAt    time.Time    `gorm:"not null" json:"endsAt"` // Exclusive
+	CreatedAt time.Time    `json:"createdAt"`
+	Product   ProductStock `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// RunningAt reports whether at falls inside the schedule.
+func (s PriceSchedule) RunningAt(at time.Time) bool {
+	return !at.Before(s.StartsAt) && at.Before(s.EndsAt)
+}
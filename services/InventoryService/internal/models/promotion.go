@@ -0,0 +1,143 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercent DiscountType = "PERCENT" // DiscountValue is a whole percentage of the price
+	DiscountFixed   DiscountType = "FIXED"   // DiscountValue is an amount in minor units of DiscountCurrency
+)
+
+// RuleMatch says whether a product has to meet all of a rule's conditions or any one of them.
+type RuleMatch string
+
+const (
+	MatchAll RuleMatch = "ALL"
+	MatchAny RuleMatch = "ANY"
+)
+
+// RuleAttribute is the product attribute a promotion condition tests.
+type RuleAttribute string
+
+const (
+	RulePrice      RuleAttribute = "price"      // Unit price in minor units of the product's currency
+	RuleQuantity   RuleAttribute = "quantity"   // Available stock
+	RuleProductID  RuleAttribute = "productId"  // The product or, for a variant, its parent
+	RuleCategoryID RuleAttribute = "categoryId" // A category subtree the product or its parent is in
+)
+
+type RuleOperator string
+
+const (
+	OpLessThan       RuleOperator = "LT"
+	OpLessOrEqual    RuleOperator = "LTE"
+	OpGreaterThan    RuleOperator = "GT"
+	OpGreaterOrEqual RuleOperator = "GTE"
+	OpEqual          RuleOperator = "EQ"
+	OpIn             RuleOperator = "IN"
+	OpNotEqual       RuleOperator = "NE" // Attribute filters only
+)
+
+// PromotionCondition compares one product attribute: price and quantity against Value, product
+// and category IDs against Values.
+type PromotionCondition struct {
+	Attribute RuleAttribute `json:"attribute"`
+	Operator  RuleOperator  `json:"operator"`
+	Value     int64         `json:"value,omitempty"`
+	Values    []string      `json:"values,omitempty"`
+}
+
+// Valid reports whether the operator and operands suit the attribute.
+func (c PromotionCondition) Valid() bool {
+	switch c.Attribute {
+	case RulePrice, RuleQuantity:
+		switch c.Operator {
+		case OpLessThan, OpLessOrEqual, OpGreaterThan, OpGreaterOrEqual, OpEqual:
+			return len(c.Values) == 0
+		}
+	case RuleProductID, RuleCategoryID:
+		return (c.Operator == OpEqual && len(c.Values) == 1) || (c.Operator == OpIn && len(c.Values) > 0)
+	}
+	return false
+}
+
+// PromotionRule selects the products a promotion applies to. A rule without conditions
+// selects every product. It is stored as JSONB.
+type PromotionRule struct {
+	Match      RuleMatch            `json:"match"`
+	Conditions []PromotionCondition `json:"conditions"`
+}
+
+func (r PromotionRule) Value() (driver.Value, error) {
+	raw, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (r *PromotionRule) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into PromotionRule", value)
+	}
+	return json.Unmarshal(raw, r)
+}
+
+// Promotion discounts the products its rule selects while it is valid. Where several active
+// promotions select a product, the one with the highest priority applies.
+type Promotion struct {
+	ID               string        `gorm:"primaryKey;size:255" json:"id"`
+	Name             string        `gorm:"size:255;not null" json:"name"`
+	Rule             PromotionRule `gorm:"type:jsonb;not null" json:"rule"`
+	DiscountType     DiscountType  `gorm:"size:16;not null" json:"discountType"`
+	DiscountValue    int64         `gorm:"not null" json:"discountValue"`
+	DiscountCurrency string        `gorm:"size:3" json:"discountCurrency,omitempty"` // FIXED only; products in other currencies are not discounted
+	StartsAt         *time.Time    `json:"startsAt,omitempty"`                       // Nil is valid from the start
+	EndsAt           *time.Time    `json:"endsAt,omitempty"`                         // Nil never expires; otherwise exclusive
+	Priority         int32         `gorm:"not null;default:0;index" json:"priority"`
+	CreatedAt        time.Time     `json:"createdAt"`
+	UpdatedAt        time.Time     `json:"updatedAt"`
+}
+
+// ActiveAt reports whether at falls in the promotion's validity window.
+func (p Promotion) ActiveAt(at time.Time) bool {
+	return (p.StartsAt == nil || !at.Before(*p.StartsAt)) && (p.EndsAt == nil || at.Before(*p.EndsAt))
+}
+
+// Apply returns price after the promotion's discount, never below zero. Percentages are
+// rounded half up to the minor unit.
+func (p Promotion) Apply(price Money) Money {
+	var off int64
+	switch p.DiscountType {
+	case DiscountPercent:
+		off = (price.MinorUnits*p.DiscountValue + 50) / 100
+	case DiscountFixed:
+		if price.Currency == p.DiscountCurrency {
+			off = p.DiscountValue
+		}
+	}
+	if off > price.MinorUnits {
+		off = price.MinorUnits
+	}
+	return Money{MinorUnits: price.MinorUnits - off, Currency: price.Currency}
+}
+
+// Offer is a product discounted by the promotion that applies to it.
+type Offer struct {
+	Product         ProductStock
+	OriginalPrice   Money
+	DiscountedPrice Money
+	Promotion       Promotion
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// OptionValues are the options that tell the variants of one product apart, keyed by option
+// name, e.g. {"size": "M", "colour": "Red"}. They are stored as JSONB.
+type OptionValues map[string]string
+
+func (o OptionValues) Value() (driver.Value, error) {
+	if len(o) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(map[string]string(o))
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (o *OptionValues) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*o = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into OptionValues", value)
+	}
+	return json.Unmarshal(raw, (*map[string]string)(o))
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrAttributeNotFound      = errors.New("attribute definition not found")
+	ErrAttributeExists        = errors.New("attribute definition already exists")
+	ErrAttributeInUse         = errors.New("attribute type or enum values cannot change while products use the values they rule out")
+	ErrInvalidAttributeValue  = errors.New("invalid attribute value")
+	ErrInvalidAttributeFilter = errors.New("invalid attribute filter")
+)
+
+// categoryAncestorsSQL selects the IDs of the given categories and all of their ancestors.
+const categoryAncestorsSQL = `WITH RECURSIVE ancestors AS (
+		SELECT id, parent_id FROM categories WHERE id IN (?)
+		UNION
+		SELECT c.id, c.parent_id FROM categories AS c JOIN ancestors AS a ON c.id = a.parent_id
+	)
+	SELECT id FROM ancestors`
+
+var filterOperators = map[models.RuleOperator]string{
+	models.OpLessThan:       "<",
+	models.OpLessOrEqual:    "<=",
+	models.OpGreaterThan:    ">",
+	models.OpGreaterOrEqual: ">=",
+}
+
+func (r *postgresCategoryRepository) CreateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error) {
+	ctx, span := r.tracer.Start(ctx, "CreateAttributeDefinition")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if definition.CategoryID != nil {
+			if err := categoryExists(tx, *definition.CategoryID); err != nil {
+				return err
+			}
+		}
+		result := tx.Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(&definition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrAttributeExists
+		}
+		return nil
+	})
+	return definition, err
+}
+
+// UpdateAttributeDefinition replaces a definition. The type cannot change while any product
+// has a value for the attribute, and an enum cannot drop a value a product still uses.
+func (r *postgresCategoryRepository) UpdateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error) {
+	ctx, span := r.tracer.Start(ctx, "UpdateAttributeDefinition")
+	defer span.End()
+
+	var updated models.AttributeDefinition
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("key = ?", definition.Key).First(&updated).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAttributeNotFound
+			}
+			return err
+		}
+		if definition.CategoryID != nil {
+			if err := categoryExists(tx, *definition.CategoryID); err != nil {
+				return err
+			}
+		}
+
+		// Products whose value the new definition would rule out
+		var ruledOut *gorm.DB
+		switch {
+		case definition.Type != updated.Type:
+			ruledOut = tx.Model(&models.ProductStock{}).Where("jsonb_exists(attributes, ?)", definition.Key)
+		case definition.Type == models.AttributeEnum:
+			ruledOut = tx.Model(&models.ProductStock{}).
+				Where("jsonb_exists(attributes, ?) AND NOT (attributes->>? IN ?)", definition.Key, definition.Key, []string(definition.EnumValues))
+		}
+		if ruledOut != nil {
+			var count int64
+			if err := ruledOut.Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return ErrAttributeInUse
+			}
+		}
+
+		updated.Name = definition.Name
+		updated.Type = definition.Type
+		updated.EnumValues = definition.EnumValues
+		updated.Unit = definition.Unit
+		updated.CategoryID = definition.CategoryID
+		return tx.Omit(clause.Associations).Save(&updated).Error
+	})
+	return updated, err
+}
+
+// DeleteAttributeDefinition removes a definition and the attribute's values from every
+// product, bumping the version of the products it touches.
+func (r *postgresCategoryRepository) DeleteAttributeDefinition(ctx context.Context, key string) error {
+	ctx, span := r.tracer.Start(ctx, "DeleteAttributeDefinition")
+	defer span.End()
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.AttributeDefinition{}, "key = ?", key)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrAttributeNotFound
+		}
+		return tx.Model(&models.ProductStock{}).
+			Where("jsonb_exists(attributes, ?)", key).
+			Updates(map[string]interface{}{
+				"attributes": gorm.Expr("NULLIF(attributes - ?, '{}'::jsonb)", key),
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+	})
+}
+
+// ListAttributeDefinitions lists every definition or, given a category, the ones that apply
+// to its products: the category's own, its ancestors' and the catalog-wide ones.
+func (r *postgresCategoryRepository) ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error) {
+	ctx, span := r.tracer.Start(ctx, "ListAttributeDefinitions")
+	defer span.End()
+
+	tx := r.db.WithContext(ctx)
+	if categoryID != "" {
+		if err := categoryExists(tx, categoryID); err != nil {
+			return nil, err
+		}
+		tx = tx.Where("category_id IS NULL OR category_id IN ("+categoryAncestorsSQL+")", []string{categoryID})
+	}
+	var definitions []models.AttributeDefinition
+	err := tx.Order("key").Find(&definitions).Error
+	return definitions, err
+}
+
+// mergeAttributes applies a patch of attribute values to a product, checking every value set
+// against its definition, which must apply to the product or, for a variant, to its parent.
+// A nil value removes the attribute. Values already stored are not checked again.
+func mergeAttributes(tx *gorm.DB, product models.ProductStock, patch models.AttributeValues) (models.AttributeValues, error) {
+	categoryOwners := []string{product.ProductID}
+	if product.ParentID != nil {
+		categoryOwners = append(categoryOwners, *product.ParentID)
+	}
+	var definitions []models.AttributeDefinition
+	if err := tx.Where(`category_id IS NULL OR category_id IN (`+categoryAncestorsSQL+`)`,
+		tx.Model(&models.ProductCategory{}).Select("category_id").Where("product_id IN ?", categoryOwners)).
+		Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]models.AttributeDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	merged := make(models.AttributeValues, len(product.Attributes)+len(patch))
+	for key, value := range product.Attributes {
+		merged[key] = value
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		definition, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is not defined for the product's categories", ErrInvalidAttributeValue, key)
+		}
+		if err := definition.Check(value); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAttributeValue, err)
+		}
+		merged[key] = value
+	}
+	return merged, nil
+}
+
+// applyAttributeFilters narrows a product query to the attribute filters, reading their
+// definitions through db. Equality uses JSONB containment, which the attributes index serves.
+func applyAttributeFilters(db *gorm.DB, tx *gorm.DB, filters []models.AttributeFilter) (*gorm.DB, error) {
+	if len(filters) == 0 {
+		return tx, nil
+	}
+	keys := make([]string, len(filters))
+	for i, filter := range filters {
+		keys[i] = filter.Key
+	}
+	var definitions []models.AttributeDefinition
+	if err := db.Where("key IN ?", keys).Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]models.AttributeDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	for _, filter := range filters {
+		definition, ok := byKey[filter.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown attribute %q", ErrInvalidAttributeFilter, filter.Key)
+		}
+		value, err := definition.ParseValue(filter.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAttributeFilter, err)
+		}
+
+		switch filter.Operator {
+		case models.OpEqual, models.OpNotEqual:
+			contained, err := json.Marshal(map[string]interface{}{filter.Key: value})
+			if err != nil {
+				return nil, err
+			}
+			condition := "COALESCE(attributes, '{}'::jsonb) @> CAST(? AS jsonb)"
+			if filter.Operator == models.OpNotEqual {
+				condition = "NOT (" + condition + ")"
+			}
+			tx = tx.Where(condition, string(contained))
+		default:
+			operator, ok := filterOperators[filter.Operator]
+			if !ok || definition.Type != models.AttributeNumber {
+				return nil, fmt.Errorf("%w: %s cannot be compared with %s", ErrInvalidAttributeFilter, filter.Key, filter.Operator)
+			}
+			// The CASE keeps the cast away from values that are not numbers
+			tx = tx.Where("CASE WHEN jsonb_typeof(attributes->?) = 'number' THEN (attributes->>?)::numeric END "+operator+" ?",
+				filter.Key, filter.Key, value)
+		}
+	}
+	return tx, nil
+}
@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrProductIsBundle = errors.New("product is a bundle; its stock is held by its components")
+	ErrNestedBundle    = errors.New("a bundle cannot be a component of another bundle")
+	ErrBundleHasStock  = errors.New("product still holds stock or reservations of its own; a bundle has none")
+	ErrBundleComponent = errors.New("product is a component of a bundle; remove it from the bundle first")
+	ErrBundleChanged   = errors.New("bundle was changed while it was being reserved; retry")
+)
+
+// bundleInStockSQL matches bundles with enough stock of every component for at least one unit.
+const bundleInStockSQL = `(` + isBundleSQL + `
+	AND NOT EXISTS (
+		SELECT 1 FROM bundle_components AS b JOIN product_stocks AS c ON c.product_id = b.component_id
+		WHERE b.bundle_id = product_stocks.product_id AND (c.quantity < b.quantity OR c.archived_at IS NOT NULL)))`
+
+// isBundleSQL matches products that have components.
+const isBundleSQL = "EXISTS (SELECT 1 FROM bundle_components AS b WHERE b.bundle_id = product_stocks.product_id)"
+
+// availableQuantitySQL is the quantity listings report for a product: for a bundle the units
+// its components make up, as loadBundles derives it, and the stored quantity otherwise.
+const availableQuantitySQL = `COALESCE((
+	SELECT MIN(CASE WHEN c.archived_at IS NULL THEN GREATEST(c.quantity, 0) / b.quantity ELSE 0 END)
+	FROM bundle_components AS b JOIN product_stocks AS c ON c.product_id = b.component_id
+	WHERE b.bundle_id = product_stocks.product_id), product_stocks.quantity)`
+
+// SetBundleComponents makes a product a bundle of the given components, replacing any it had,
+// and returns it with its derived stock. No components make it an ordinary product again;
+// reservations already made keep holding the components they were made with.
+func (r *postgresRepository) SetBundleComponents(ctx context.Context, bundleID string, components []models.BundleComponent) (models.ProductStock, error) {
+	ctx, span := r.tracer.Start(ctx, "SetBundleComponents")
+	defer span.End()
+
+	var bundle models.ProductStock
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		productIDs := []string{bundleID}
+		for _, component := range components {
+			productIDs = append(productIDs, component.ComponentID)
+		}
+		// Locking the components keeps them from becoming bundles themselves meanwhile
+		stocks, err := lockStocks(tx, productIDs)
+		if err != nil {
+			return err
+		}
+		var ok bool
+		if bundle, ok = stocks[bundleID]; !ok {
+			return ErrProductNotFound
+		}
+		if bundle.ArchivedAt != nil {
+			return ErrProductArchived
+		}
+		if err := replaceBundleComponents(tx, bundle, components, stocks); err != nil {
+			return err
+		}
+		bundle.Version++
+		if err := tx.Model(&bundle).Update("version", bundle.Version).Error; err != nil {
+			return err
+		}
+		return hydrateProducts(tx, time.Now(), &bundle)
+	})
+	return bundle, err
+}
+
+// replaceBundleComponents gives a locked, live product the given components in place of any
+// it had. The components must be locked too, with their rows in stocks.
+func replaceBundleComponents(tx *gorm.DB, bundle models.ProductStock, components []models.BundleComponent, stocks map[string]models.ProductStock) error {
+	if len(components) > 0 {
+		productIDs := []string{bundle.ProductID}
+		for _, component := range components {
+			productIDs = append(productIDs, component.ComponentID)
+		}
+		parents, err := parentsWithVariants(tx, productIDs)
+		if err != nil {
+			return err
+		}
+		if parents[bundle.ProductID] {
+			return ErrProductHasVariants
+		}
+		if err := checkBundle(tx, bundle); err != nil {
+			return err
+		}
+		bundles, err := loadBundleComponents(tx, productIDs[1:])
+		if err != nil {
+			return err
+		}
+		for _, component := range components {
+			stock, ok := stocks[component.ComponentID]
+			switch {
+			case !ok:
+				return fmt.Errorf("component %s: %w", component.ComponentID, ErrProductNotFound)
+			case stock.ArchivedAt != nil:
+				return fmt.Errorf("component %s: %w", component.ComponentID, ErrProductArchived)
+			case parents[component.ComponentID]:
+				return fmt.Errorf("component %s: %w", component.ComponentID, ErrProductHasVariants)
+			case bundles[component.ComponentID] != nil:
+				return ErrNestedBundle
+			}
+		}
+	}
+
+	if err := tx.Where("bundle_id = ?", bundle.ProductID).Delete(&models.BundleComponent{}).Error; err != nil {
+		return err
+	}
+	if len(components) == 0 {
+		return nil
+	}
+	for i := range components {
+		components[i].BundleID = bundle.ProductID
+	}
+	return tx.Omit(clause.Associations).Create(&components).Error
+}
+
+// checkBundle refuses to turn a product into a bundle while it holds stock of its own, which
+// could then never be reserved, or is a component of another bundle.
+func checkBundle(tx *gorm.DB, product models.ProductStock) error {
+	if product.Quantity > 0 || product.Reserved > 0 {
+		return ErrBundleHasStock
+	}
+	// Confirmed lines reserved before it was a bundle would return stock to the product itself
+	var open int64
+	if err := tx.Model(&models.Reservation{}).
+		Where("product_id = ? AND status IN ?", product.ProductID, []models.ReservationStatus{models.ReservationPending, models.ReservationConfirmed}).
+		Where("NOT EXISTS (SELECT 1 FROM reserved_components AS h WHERE h.order_id = reservations.order_id AND h.bundle_id = reservations.product_id)").
+		Count(&open).Error; err != nil {
+		return err
+	}
+	if open > 0 {
+		return ErrBundleHasStock
+	}
+	var uses int64
+	if err := tx.Model(&models.BundleComponent{}).Where("component_id = ?", product.ProductID).Count(&uses).Error; err != nil {
+		return err
+	}
+	if uses > 0 {
+		return ErrNestedBundle
+	}
+	return nil
+}
+
+// loadBundleComponents returns the components of whichever of the given products are bundles,
+// keyed by bundle ID and sorted by component ID.
+func loadBundleComponents(tx *gorm.DB, productIDs []string) (map[string][]models.BundleComponent, error) {
+	bundles := make(map[string][]models.BundleComponent)
+	if len(productIDs) == 0 {
+		return bundles, nil
+	}
+	var components []models.BundleComponent
+	if err := tx.Where("bundle_id IN ?", productIDs).Order("bundle_id, component_id").Find(&components).Error; err != nil {
+		return nil, err
+	}
+	for _, component := range components {
+		bundles[component.BundleID] = append(bundles[component.BundleID], component)
+	}
+	return bundles, nil
+}
+
+// lockWithComponents locks the given products together with the components of the bundles
+// among them, all in one sorted query like lockStocks, so reservations mixing bundles and
+// their components cannot deadlock. It returns the locked rows and the bundles' components.
+func lockWithComponents(tx *gorm.DB, productIDs []string) (map[string]models.ProductStock, map[string][]models.BundleComponent, error) {
+	bundles, err := loadBundleComponents(tx, productIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	locked := make(map[string]bool, len(productIDs))
+	ids := append([]string(nil), productIDs...)
+	for _, id := range productIDs {
+		locked[id] = true
+	}
+	for _, components := range bundles {
+		for _, component := range components {
+			if !locked[component.ComponentID] {
+				locked[component.ComponentID] = true
+				ids = append(ids, component.ComponentID)
+			}
+		}
+	}
+	stocks, err := lockStocks(tx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The bundles are locked now, so their components can no longer change; read them again
+	// in case they changed before
+	bundles, err = loadBundleComponents(tx, productIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, components := range bundles {
+		for _, component := range components {
+			if !locked[component.ComponentID] {
+				return nil, nil, ErrBundleChanged
+			}
+		}
+	}
+	return stocks, bundles, nil
+}
+
+// checkComponents refuses to reserve a bundle whose components cannot all be reserved.
+func checkComponents(components []models.BundleComponent, stocks map[string]models.ProductStock) error {
+	for _, component := range components {
+		if stocks[component.ComponentID].ArchivedAt != nil {
+			return fmt.Errorf("component %s: %w", component.ComponentID, ErrProductArchived)
+		}
+	}
+	return nil
+}
+
+// bundleAvailable is how many units of a bundle its components' available stock allows. An
+// archived component allows none.
+func bundleAvailable(components []models.BundleComponent, stocks map[string]models.ProductStock) int32 {
+	available := int32(math.MaxInt32)
+	for _, component := range components {
+		stock, ok := stocks[component.ComponentID]
+		if !ok || stock.ArchivedAt != nil {
+			return 0
+		}
+		available = min(available, max(stock.Quantity, 0)/component.Quantity)
+	}
+	return available
+}
+
+// holdStock returns the deltas that move quantity units of a product from available to
+// reserved stock. A bundle has no stock of its own, so its components are held instead and
+// recorded against the order's line. Callers must have checked that the stock is available.
+func holdStock(orderID string, productID string, quantity int32, components []models.BundleComponent) ([]stockDelta, []models.ReservedComponent) {
+	if len(components) == 0 {
+		return []stockDelta{{ProductID: productID, Quantity: -quantity, Reserved: quantity}}, nil
+	}
+	deltas := make([]stockDelta, 0, len(components))
+	held := make([]models.ReservedComponent, 0, len(components))
+	for _, component := range components {
+		units := quantity * component.Quantity
+		deltas = append(deltas, stockDelta{ProductID: component.ComponentID, Quantity: -units, Reserved: units})
+		held = append(held, models.ReservedComponent{OrderID: orderID, BundleID: productID, ComponentID: component.ComponentID, Quantity: units})
+	}
+	return deltas, held
+}
+
+// lineKey identifies one ledger line.
+type lineKey struct {
+	OrderID   string
+	ProductID string
+}
+
+// loadReservedComponents returns what each of the given bundle lines holds of its components.
+// Lines of ordinary products are absent from the map.
+func loadReservedComponents(tx *gorm.DB, lines []models.Reservation) (map[lineKey][]models.ReservedComponent, error) {
+	held := make(map[lineKey][]models.ReservedComponent)
+	if len(lines) == 0 {
+		return held, nil
+	}
+	keys := make([][]interface{}, 0, len(lines))
+	for _, line := range lines {
+		keys = append(keys, []interface{}{line.OrderID, line.ProductID})
+	}
+	var components []models.ReservedComponent
+	if err := tx.Where("(order_id, bundle_id) IN ?", keys).Order("component_id").Find(&components).Error; err != nil {
+		return nil, err
+	}
+	for _, component := range components {
+		key := lineKey{OrderID: component.OrderID, ProductID: component.BundleID}
+		held[key] = append(held[key], component)
+	}
+	return held, nil
+}
+
+// perUnit turns what a bundle line holds back into the components of one unit of the bundle.
+func perUnit(line models.Reservation, held []models.ReservedComponent) []models.BundleComponent {
+	components := make([]models.BundleComponent, 0, len(held))
+	for _, component := range held {
+		components = append(components, models.BundleComponent{BundleID: line.ProductID, ComponentID: component.ComponentID, Quantity: component.Quantity / line.Quantity})
+	}
+	return components
+}
+
+// loadBundles fills in the components of the bundles among the products and their loaded
+// variants, and derives their stock from their components' available stock.
+func loadBundles(tx *gorm.DB, products ...*models.ProductStock) error {
+	all := withVariants(products)
+	if len(all) == 0 {
+		return nil
+	}
+	productIDs := make([]string, 0, len(all))
+	for _, product := range all {
+		productIDs = append(productIDs, product.ProductID)
+	}
+	bundles, err := loadBundleComponents(tx, productIDs)
+	if err != nil || len(bundles) == 0 {
+		return err
+	}
+
+	var componentIDs []string
+	for _, components := range bundles {
+		for _, component := range components {
+			componentIDs = append(componentIDs, component.ComponentID)
+		}
+	}
+	var rows []models.ProductStock
+	if err := tx.Select("product_id", "quantity", "archived_at").Where("product_id IN ?", componentIDs).Find(&rows).Error; err != nil {
+		return err
+	}
+	stocks := make(map[string]models.ProductStock, len(rows))
+	for _, row := range rows {
+		stocks[row.ProductID] = row
+	}
+
+	for _, product := range all {
+		if components, ok := bundles[product.ProductID]; ok {
+			product.Components = components
+			product.Quantity = bundleAvailable(components, stocks)
+			product.Reserved = 0
+		}
+	}
+	return nil
+}
@@ -0,0 +1,393 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrImportJobNotFound = errors.New("import job not found")
+	ErrParentChanged     = errors.New("a product cannot become a variant, stop being one, or move to another parent")
+)
+
+// errDryRun rolls back the transaction of a dry run once every record has been tried.
+var errDryRun = errors.New("dry run")
+
+type CatalogRepository interface {
+	UpsertProducts(ctx context.Context, records []models.CatalogRecord, dryRun bool) ([]models.UpsertResult, error)
+	ExportProducts(ctx context.Context, after *models.CatalogRecord, limit int) ([]models.CatalogRecord, error)
+	CreateImportJob(ctx context.Context, job models.ImportJob) (models.ImportJob, error)
+	GetImportJob(ctx context.Context, jobID int64) (models.ImportJob, error)
+	ClaimImportJob(ctx context.Context, staleBefore time.Time) (models.ImportJob, error)
+	SaveImportProgress(ctx context.Context, job models.ImportJob) error
+}
+
+type postgresCatalogRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+func NewPostgresCatalogRepository(db *gorm.DB) CatalogRepository {
+	return &postgresCatalogRepository{
+		db:     db,
+		tracer: otel.Tracer("CatalogRepository"),
+	}
+}
+
+// UpsertProducts creates or replaces each record in order, in one transaction. Every record
+// runs in a savepoint of its own, so a failing record is reported and skipped while the
+// others are kept. A dry run rolls everything back once the outcomes are known.
+func (r *postgresCatalogRepository) UpsertProducts(ctx context.Context, records []models.CatalogRecord, dryRun bool) ([]models.UpsertResult, error) {
+	ctx, span := r.tracer.Start(ctx, "UpsertProducts")
+	defer span.End()
+
+	results := make([]models.UpsertResult, len(records))
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, record := range records {
+			results[i] = models.UpsertResult{ProductID: record.ProductID}
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				outcome, err := upsertRecord(tx, record)
+				results[i].Outcome = outcome
+				return err
+			})
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				results[i].Outcome = models.UpsertFailed
+				results[i].Message = err.Error()
+			}
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ExportProducts returns up to limit catalog records following after, or from the start when
+// after is nil. Top-level products come first, then variants, then bundles, each in product
+// ID order, so the records can be imported again in the order they were exported. Archived
+// products are left out.
+func (r *postgresCatalogRepository) ExportProducts(ctx context.Context, after *models.CatalogRecord, limit int) ([]models.CatalogRecord, error) {
+	ctx, span := r.tracer.Start(ctx, "ExportProducts")
+	defer span.End()
+
+	tx := r.db.WithContext(ctx).Where("archived_at IS NULL")
+	if after != nil {
+		tx = tx.Where("("+isBundleSQL+", parent_id IS NOT NULL, product_id) > (?, ?, ?)", len(after.Components) > 0, after.ParentID != nil, after.ProductID)
+	}
+	var products []models.ProductStock
+	if err := tx.Order(isBundleSQL).Order("parent_id IS NOT NULL").Order("product_id").Limit(limit).Find(&products).Error; err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, nil
+	}
+
+	productIDs := make([]string, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ProductID
+	}
+	var assignments []models.ProductCategory
+	if err := r.db.WithContext(ctx).Where("product_id IN ?", productIDs).Order("category_id").Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	categories := make(map[string][]string, len(products))
+	for _, assignment := range assignments {
+		categories[assignment.ProductID] = append(categories[assignment.ProductID], assignment.CategoryID)
+	}
+
+	bundles, err := loadBundleComponents(r.db.WithContext(ctx), productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]models.CatalogRecord, len(products))
+	for i, product := range products {
+		product.Components = bundles[product.ProductID]
+		records[i] = toCatalogRecord(product, categories[product.ProductID])
+	}
+	return records, nil
+}
+
+func (r *postgresCatalogRepository) CreateImportJob(ctx context.Context, job models.ImportJob) (models.ImportJob, error) {
+	ctx, span := r.tracer.Start(ctx, "CreateImportJob")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Create(&job).Error
+	return job, err
+}
+
+// GetImportJob returns a job without its uploaded file.
+func (r *postgresCatalogRepository) GetImportJob(ctx context.Context, jobID int64) (models.ImportJob, error) {
+	ctx, span := r.tracer.Start(ctx, "GetImportJob")
+	defer span.End()
+
+	var job models.ImportJob
+	if err := r.db.WithContext(ctx).Omit("payload").First(&job, jobID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return job, ErrImportJobNotFound
+		}
+		return job, err
+	}
+	return job, nil
+}
+
+// ClaimImportJob marks the oldest pending job as running and returns it with its file. A
+// running job that has made no progress since staleBefore is taken over as well, since the
+// instance that ran it is presumed gone; its counts start again. Jobs claimed by other
+// instances are skipped. It returns ErrImportJobNotFound when there is nothing to run.
+func (r *postgresCatalogRepository) ClaimImportJob(ctx context.Context, staleBefore time.Time) (models.ImportJob, error) {
+	ctx, span := r.tracer.Start(ctx, "ClaimImportJob")
+	defer span.End()
+
+	var job models.ImportJob
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND updated_at < ?)", models.ImportPending, models.ImportRunning, staleBefore).
+			Order("id").
+			First(&job).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrImportJobNotFound
+			}
+			return err
+		}
+
+		now := time.Now()
+		job.Status = models.ImportRunning
+		job.StartedAt = &now
+		job.Total, job.Processed, job.Created, job.Updated, job.Failed = 0, 0, 0, 0, 0
+		job.Errors = nil
+		return tx.Model(&job).
+			Select("status", "started_at", "total", "processed", "created", "updated", "failed", "errors").
+			Updates(&job).Error
+	})
+	return job, err
+}
+
+// SaveImportProgress records a job's counts, errors and status. The uploaded file is dropped
+// once the job has finished.
+func (r *postgresCatalogRepository) SaveImportProgress(ctx context.Context, job models.ImportJob) error {
+	ctx, span := r.tracer.Start(ctx, "SaveImportProgress")
+	defer span.End()
+
+	columns := []interface{}{"total", "processed", "created", "updated", "failed", "errors", "message", "finished_at"}
+	if job.Status == models.ImportSucceeded || job.Status == models.ImportFailed {
+		job.Payload = nil
+		columns = append(columns, "payload")
+	}
+	return r.db.WithContext(ctx).Model(&job).Select("status", columns...).Updates(&job).Error
+}
+
+// upsertRecord creates the record's product or replaces the catalog fields of the existing
+// one. Stock of an existing product is left alone.
+func upsertRecord(tx *gorm.DB, record models.CatalogRecord) (models.UpsertOutcome, error) {
+	var product models.ProductStock
+	err := forUpdate(tx).Where("product_id = ?", record.ProductID).First(&product).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.UpsertCreated, insertRecord(tx, record)
+	}
+	if err != nil {
+		return "", err
+	}
+	return models.UpsertUpdated, updateRecord(tx, product, record)
+}
+
+func insertRecord(tx *gorm.DB, record models.CatalogRecord) error {
+	product := models.ProductStock{
+		ProductID: record.ProductID,
+		ParentID:  record.ParentID,
+		Name:      record.Name,
+		Options:   record.Options,
+		Quantity:  record.Quantity,
+	}
+	if record.Description != nil {
+		product.Description = *record.Description
+	}
+
+	if product.ParentID != nil {
+		if record.UnitPrice != nil {
+			product.UnitPriceOverride = &record.UnitPrice.MinorUnits
+		}
+		if err := insertVariant(tx, &product); err != nil {
+			return err
+		}
+		if record.UnitPrice != nil && record.UnitPrice.Currency != "" && record.UnitPrice.Currency != product.UnitPrice.Currency {
+			return ErrCurrencyMismatch
+		}
+	} else {
+		product.SetUnitPrice(*record.UnitPrice)
+		if err := tx.Omit("Reserved", clause.Associations).Create(&product).Error; err != nil {
+			return err
+		}
+	}
+
+	if record.Categories != nil {
+		if err := replaceProductCategories(tx, product.ProductID, record.Categories); err != nil {
+			return err
+		}
+	}
+	if len(record.Components) > 0 {
+		if err := importComponents(tx, product, record.Components); err != nil {
+			return err
+		}
+	}
+	if record.Attributes == nil {
+		return nil
+	}
+	attributes, err := mergeAttributes(tx, product, record.Attributes)
+	if err != nil {
+		return err
+	}
+	return tx.Model(&product).Update("attributes", attributes).Error
+}
+
+// updateRecord replaces the catalog fields of a locked product. A variant without a price
+// goes back to following its parent's price.
+func updateRecord(tx *gorm.DB, product models.ProductStock, record models.CatalogRecord) error {
+	if product.ArchivedAt != nil {
+		return ErrProductArchived
+	}
+	if (product.ParentID == nil) != (record.ParentID == nil) || (product.ParentID != nil && *product.ParentID != *record.ParentID) {
+		return ErrParentChanged
+	}
+
+	updates := map[string]interface{}{}
+	if record.Name != "" {
+		product.Name = record.Name
+		updates["name"] = product.Name
+	}
+	if record.Description != nil {
+		product.Description = *record.Description
+		updates["description"] = product.Description
+	}
+
+	if product.ParentID == nil {
+		if err := checkCurrencyChange(tx, product, record.UnitPrice.Currency); err != nil {
+			return err
+		}
+		product.SetUnitPrice(*record.UnitPrice)
+	} else {
+		if err := checkUniqueOptions(tx, *product.ParentID, product.ProductID, record.Options); err != nil {
+			return err
+		}
+		product.Options = record.Options
+		updates["options"] = product.Options
+
+		var parent models.ProductStock
+		if err := tx.Select("price_minor_units", "price_currency").Where("product_id = ?", *product.ParentID).First(&parent).Error; err != nil {
+			return err
+		}
+		price := parent.UnitPrice
+		var override *int64
+		if record.UnitPrice != nil {
+			if record.UnitPrice.Currency != "" && record.UnitPrice.Currency != price.Currency {
+				return ErrCurrencyMismatch
+			}
+			override = &record.UnitPrice.MinorUnits
+			price.MinorUnits = *override
+		}
+		product.SetUnitPrice(price)
+		product.SetUnitPriceOverride(override)
+		updates["price_override_minor_units"] = product.UnitPriceOverride
+		updates["price_override"] = product.PriceOverride
+	}
+	updates["price_minor_units"] = product.UnitPrice.MinorUnits
+	updates["price_currency"] = product.UnitPrice.Currency
+	updates["price"] = product.Price
+
+	if record.Categories != nil {
+		if err := replaceProductCategories(tx, product.ProductID, record.Categories); err != nil {
+			return err
+		}
+	}
+	if record.Attributes != nil {
+		// Replace rather than merge: every current value the record leaves out is removed
+		patch := make(models.AttributeValues, len(product.Attributes)+len(record.Attributes))
+		for key := range product.Attributes {
+			patch[key] = nil
+		}
+		for key, value := range record.Attributes {
+			patch[key] = value
+		}
+		attributes, err := mergeAttributes(tx, product, patch)
+		if err != nil {
+			return err
+		}
+		product.Attributes = attributes
+		updates["attributes"] = attributes
+	}
+	if record.Components != nil {
+		if err := importComponents(tx, product, record.Components); err != nil {
+			return err
+		}
+	}
+
+	product.Version++
+	updates["version"] = product.Version
+	if err := tx.Model(&product).Updates(updates).Error; err != nil {
+		return err
+	}
+	if product.ParentID != nil {
+		return nil
+	}
+	return followParentPrice(tx, product)
+}
+
+// toCatalogRecord describes a product as a catalog record. A variant's price is its override,
+// if it has one.
+func toCatalogRecord(product models.ProductStock, categories []string) models.CatalogRecord {
+	description := product.Description
+	record := models.CatalogRecord{
+		ProductID:   product.ProductID,
+		ParentID:    product.ParentID,
+		Name:        product.Name,
+		Description: &description,
+		Quantity:    product.Quantity,
+		Options:     product.Options,
+		Categories:  categories,
+		Attributes:  product.Attributes,
+		Components:  product.Components,
+	}
+	if product.ParentID == nil {
+		price := product.UnitPrice
+		record.UnitPrice = &price
+	} else if product.UnitPriceOverride != nil {
+		record.UnitPrice = &models.Money{MinorUnits: *product.UnitPriceOverride, Currency: product.UnitPrice.Currency}
+	}
+	if record.Categories == nil {
+		record.Categories = []string{}
+	}
+	if record.Attributes == nil {
+		record.Attributes = models.AttributeValues{}
+	}
+	if record.Components == nil {
+		record.Components = []models.BundleComponent{}
+	}
+	return record
+}
+
+// importComponents replaces the components of a locked product from a catalog record.
+func importComponents(tx *gorm.DB, product models.ProductStock, components []models.BundleComponent) error {
+	componentIDs := make([]string, len(components))
+	for i, component := range components {
+		componentIDs[i] = component.ComponentID
+	}
+	stocks, err := lockStocks(tx, componentIDs)
+	if err != nil {
+		return err
+	}
+	return replaceBundleComponents(tx, product, components, stocks)
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategoryExists      = errors.New("category already exists")
+	ErrCategoryHasChildren = errors.New("category has subcategories")
+	ErrCategoryCycle       = errors.New("category cannot be moved under itself or its subcategories")
+)
+
+// categorySubtreeSQL selects the IDs of a category and all of its descendants. UNION drops
+// rows already visited, so the recursion ends even if the tree ever holds a cycle.
+const categorySubtreeSQL = `WITH RECURSIVE subtree AS (
+		SELECT id FROM categories WHERE id = ?
+		UNION
+		SELECT c.id FROM categories AS c JOIN subtree AS s ON c.parent_id = s.id
+	)
+	SELECT id FROM subtree`
+
+// categoryMoveLockKey is the advisory lock key that serializes category moves. Two moves
+// checked concurrently could each pass the cycle check and together commit a cycle.
+const categoryMoveLockKey int64 = 0x63617465676f7279 // "category"
+
+type CategoryRepository interface {
+	CreateCategory(ctx context.Context, category models.Category) (models.Category, error)
+	UpdateCategory(ctx context.Context, category models.Category) (models.Category, error)
+	DeleteCategory(ctx context.Context, categoryID string) error
+	GetCategory(ctx context.Context, categoryID string) (models.Category, error)
+	ListCategories(ctx context.Context) ([]models.Category, error)
+	SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error
+	GetProductCategories(ctx context.Context, productID string) ([]models.Category, error)
+	CreateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error)
+	UpdateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error)
+	DeleteAttributeDefinition(ctx context.Context, key string) error
+	ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error)
+}
+
+type postgresCategoryRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+func NewPostgresCategoryRepository(db *gorm.DB) CategoryRepository {
+	return &postgresCategoryRepository{
+		db:     db,
+		tracer: otel.Tracer("CategoryRepository"),
+	}
+}
+
+func (r *postgresCategoryRepository) CreateCategory(ctx context.Context, category models.Category) (models.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "CreateCategory")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if category.ParentID != nil {
+			if err := categoryExists(tx, *category.ParentID); err != nil {
+				return fmt.Errorf("parent %w", err)
+			}
+		}
+		result := tx.Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(&category)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrCategoryExists
+		}
+		return nil
+	})
+	return category, err
+}
+
+// UpdateCategory renames a category or moves it, subtree included, under another parent.
+func (r *postgresCategoryRepository) UpdateCategory(ctx context.Context, category models.Category) (models.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "UpdateCategory")
+	defer span.End()
+
+	var updated models.Category
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if category.ParentID != nil {
+			// Held until commit, so the next move checks against the tree this one leaves
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", categoryMoveLockKey).Error; err != nil {
+				return err
+			}
+		}
+		if err := forUpdate(tx).Where("id = ?", category.ID).First(&updated).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCategoryNotFound
+			}
+			return err
+		}
+
+		if category.ParentID != nil {
+			if err := categoryExists(tx, *category.ParentID); err != nil {
+				return fmt.Errorf("parent %w", err)
+			}
+			// The new parent must not be the category itself or one of its descendants
+			var inSubtree int64
+			if err := tx.Raw(`SELECT count(*) FROM (`+categorySubtreeSQL+`) AS t WHERE t.id = ?`, category.ID, *category.ParentID).
+				Scan(&inSubtree).Error; err != nil {
+				return err
+			}
+			if inSubtree > 0 {
+				return ErrCategoryCycle
+			}
+		}
+
+		updated.Name = category.Name
+		updated.ParentID = category.ParentID
+		return tx.Omit(clause.Associations).Save(&updated).Error
+	})
+	return updated, err
+}
+
+// DeleteCategory removes a leaf category together with its product assignments. Categories
+// that still have subcategories are refused rather than orphaning or deleting them.
+func (r *postgresCategoryRepository) DeleteCategory(ctx context.Context, categoryID string) error {
+	ctx, span := r.tracer.Start(ctx, "DeleteCategory")
+	defer span.End()
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var children int64
+		if err := tx.Model(&models.Category{}).Where("parent_id = ?", categoryID).Count(&children).Error; err != nil {
+			return err
+		}
+		if children > 0 {
+			return ErrCategoryHasChildren
+		}
+
+		result := tx.Delete(&models.Category{}, "id = ?", categoryID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrCategoryNotFound
+		}
+		return nil
+	})
+}
+
+func (r *postgresCategoryRepository) GetCategory(ctx context.Context, categoryID string) (models.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "GetCategory")
+	defer span.End()
+
+	var category models.Category
+	err := r.db.WithContext(ctx).Where("id = ?", categoryID).First(&category).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return category, ErrCategoryNotFound
+	}
+	return category, err
+}
+
+func (r *postgresCategoryRepository) ListCategories(ctx context.Context) ([]models.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "ListCategories")
+	defer span.End()
+
+	var categories []models.Category
+	err := r.db.WithContext(ctx).Order("name").Order("id").Find(&categories).Error
+	return categories, err
+}
+
+// SetProductCategories replaces every category assignment of a product.
+func (r *postgresCategoryRepository) SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error {
+	ctx, span := r.tracer.Start(ctx, "SetProductCategories")
+	defer span.End()
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var product models.ProductStock
+		if err := forUpdate(tx).Where("product_id = ?", productID).First(&product).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+		return replaceProductCategories(tx, productID, categoryIDs)
+	})
+}
+
+func (r *postgresCategoryRepository) GetProductCategories(ctx context.Context, productID string) ([]models.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "GetProductCategories")
+	defer span.End()
+
+	var categories []models.Category
+	err := r.db.WithContext(ctx).
+		Joins("JOIN product_categories AS pc ON pc.category_id = categories.id").
+		Where("pc.product_id = ?", productID).
+		Order("categories.name").
+		Find(&categories).Error
+	return categories, err
+}
+
+// replaceProductCategories replaces every category assignment of a product the caller has
+// locked.
+func replaceProductCategories(tx *gorm.DB, productID string, categoryIDs []string) error {
+	var assignments []models.ProductCategory
+	seen := make(map[string]bool)
+	for _, categoryID := range categoryIDs {
+		if seen[categoryID] {
+			continue
+		}
+		seen[categoryID] = true
+		if err := categoryExists(tx, categoryID); err != nil {
+			return err
+		}
+		assignments = append(assignments, models.ProductCategory{ProductID: productID, CategoryID: categoryID})
+	}
+
+	if err := tx.Where("product_id = ?", productID).Delete(&models.ProductCategory{}).Error; err != nil {
+		return err
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+	return tx.Omit(clause.Associations).Create(&assignments).Error
+}
+
+func categoryExists(tx *gorm.DB, categoryID string) error {
+	var count int64
+	if err := tx.Model(&models.Category{}).Where("id = ?", categoryID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrCategoryNotFound
+	}
+	return nil
+}
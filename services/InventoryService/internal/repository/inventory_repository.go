@@ -2,27 +2,63 @@ package repository
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"inventory-service/internal/models"
+	"sort"
+	"strings"
+	"time"
+
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrProductNotFound      = errors.New("product not found")
+	ErrReservationNotFound  = errors.New("reservation not found")
+	ErrReservationReleased  = errors.New("reservation already released")
+	ErrReservationConfirmed = errors.New("reservation already confirmed")
+	ErrIdempotencyConflict  = errors.New("order already reserved with a different request")
+	ErrProductHasVariants   = errors.New("product has variants; reserve or remove one of its SKUs instead")
+	ErrProductArchived      = errors.New("product is archived")
+	ErrProductNotArchived   = errors.New("product must be archived before it is purged")
+	ErrOpenReservations     = errors.New("product still has open reservations")
+	ErrNegativeStock        = errors.New("adjustment would take available stock below zero")
+	ErrVersionConflict      = errors.New("product was changed by someone else; reload and retry")
+	ErrCurrencyMismatch     = errors.New("variants are priced in their parent's currency")
+	ErrInvalidQuantity      = errors.New("reserved quantity must be positive")
 )
 
 type InventoryRepository interface {
-	ReserveStock(ctx context.Context, orderID string, productID string, quantity int32) error
-	ReleaseStock(ctx context.Context, orderID string, productID string, quantity int32) error
-	BatchReserveStock(ctx context.Context, orderID string, items []models.BatchItem) error
-	BatchReleaseStock(ctx context.Context, orderID string, items []models.BatchItem) error
+	ReserveStock(ctx context.Context, orderID string, productID string, quantity int32, expiresAt time.Time) error
+	ReleaseStock(ctx context.Context, orderID string, productID string) (int32, error)
+	BatchReserveStock(ctx context.Context, orderID string, items []models.BatchItem, expiresAt time.Time, allowPartial bool) ([]models.BatchItemResult, error)
+	BatchReleaseStock(ctx context.Context, orderID string) ([]models.BatchItemResult, error)
+	ConfirmReservation(ctx context.Context, orderID string) error
+	CancelReservation(ctx context.Context, orderID string) ([]models.BatchItemResult, error)
+	ExpireReservation(ctx context.Context, orderID string, now time.Time) ([]models.BatchItemResult, error)
+	ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]string, error)
+	GetReservation(ctx context.Context, orderID string) ([]models.Reservation, error)
+	PurgeCompletedReservations(ctx context.Context, before time.Time, limit int) (int64, int64, error)
 	GetStock(ctx context.Context, productID string) (int32, error)
 	GetProduct(ctx context.Context, productID string) (models.ProductStock, error)
-	ListProducts(ctx context.Context) ([]models.ProductStock, error)
-	GetOffers(ctx context.Context) ([]models.ProductStock, error)
+	ListProducts(ctx context.Context, query models.ProductQuery) (models.ProductPage, error)
+	SearchProducts(ctx context.Context, query models.SearchQuery) (models.SearchPage, error)
 	CreateProduct(ctx context.Context, product models.ProductStock) error
-	UpdateProduct(ctx context.Context, product models.ProductStock) error
-	DeleteProduct(ctx context.Context, productID string) error
+	PatchProduct(ctx context.Context, productID string, patch models.ProductPatch) (models.ProductStock, error)
+	AdjustStock(ctx context.Context, productID string, delta int32) (models.ProductStock, error)
+	ArchiveProduct(ctx context.Context, productID string) error
+	RestoreProduct(ctx context.Context, productID string) (models.ProductStock, error)
+	PurgeProduct(ctx context.Context, productID string) ([]models.ProductImage, error)
+	CreateVariant(ctx context.Context, variant models.ProductStock) (models.ProductStock, error)
+	UpdateVariant(ctx context.Context, productID string, options models.OptionValues, priceOverride *int64) (models.ProductStock, error)
+	ListVariants(ctx context.Context, parentID string) ([]models.ProductStock, error)
 	RestockItems(ctx context.Context, productID string, quantity int32) error
+	SetBundleComponents(ctx context.Context, bundleID string, components []models.BundleComponent) (models.ProductStock, error)
 }
 
 type postgresRepository struct {
@@ -37,23 +73,53 @@ func NewPostgresRepository(db *gorm.DB) InventoryRepository {
 	}
 }
 
-func (r *postgresRepository) ListProducts(ctx context.Context) ([]models.ProductStock, error) {
+// ListProducts returns one page of products using keyset pagination on (sort column,
+// product ID), so deep pages cost the same as the first and concurrent inserts do not shift
+// rows between pages. query.PageSize must be positive.
+func (r *postgresRepository) ListProducts(ctx context.Context, query models.ProductQuery) (models.ProductPage, error) {
 	ctx, span := r.tracer.Start(ctx, "ListProducts")
 	defer span.End()
 
-	var products []models.ProductStock
-	err := r.db.WithContext(ctx).Find(&products).Error
-	return products, err
-}
+	tx, err := applyAttributeFilters(r.db.WithContext(ctx), applyProductFilters(r.db.WithContext(ctx), query), query.Attributes)
+	if err != nil {
+		return models.ProductPage{}, err
+	}
+	tx, err = applyProductPage(tx, query)
+	if err != nil {
+		return models.ProductPage{}, err
+	}
 
-func (r *postgresRepository) GetOffers(ctx context.Context) ([]models.ProductStock, error) {
-	ctx, span := r.tracer.Start(ctx, "GetOffers")
-	defer span.End()
+	if query.GroupVariants {
+		tx = tx.Preload("Variants", func(db *gorm.DB) *gorm.DB { return db.Where("archived_at IS NULL").Order("product_id") })
+	}
 
+	// Fetch one extra row to learn whether another page follows
 	var products []models.ProductStock
-	// Define "Offers" as products with price < 50 or quantity < 10
-	err := r.db.WithContext(ctx).Where("price < ? OR quantity < ?", 50.0, 10).Find(&products).Error
-	return products, err
+	if err := tx.Limit(query.PageSize + 1).Find(&products).Error; err != nil {
+		return models.ProductPage{}, err
+	}
+
+	// The cursor holds the values the query sorted on: a bundle's derived stock, but the stored
+	// price rather than any scheduled one
+	page := models.ProductPage{Products: products}
+	if len(products) > query.PageSize {
+		page.Products = products[:query.PageSize]
+	}
+	if err := loadBundles(r.db.WithContext(ctx), productPointers(page.Products)...); err != nil {
+		return models.ProductPage{}, err
+	}
+	if len(products) > query.PageSize {
+		token, err := encodeProductCursor(query, page.Products[query.PageSize-1])
+		if err != nil {
+			return models.ProductPage{}, err
+		}
+		page.NextPageToken = token
+	}
+
+	if err := hydrateCatalog(r.db.WithContext(ctx), time.Now(), productPointers(page.Products)...); err != nil {
+		return models.ProductPage{}, err
+	}
+	return page, nil
 }
 
 func (r *postgresRepository) CreateProduct(ctx context.Context, product models.ProductStock) error {
@@ -63,153 +129,941 @@ func (r *postgresRepository) CreateProduct(ctx context.Context, product models.P
 	return r.db.WithContext(ctx).Create(&product).Error
 }
 
-func (r *postgresRepository) UpdateProduct(ctx context.Context, product models.ProductStock) error {
-	ctx, span := r.tracer.Start(ctx, "UpdateProduct")
+// PatchProduct changes only the catalog fields set in patch and returns the updated product.
+// The write is refused with ErrVersionConflict unless patch.Version is still current or
+// patch.AnyVersion is set. For a variant a new price becomes its price override; for a parent it
+// flows to every variant without an override.
+func (r *postgresRepository) PatchProduct(ctx context.Context, productID string, patch models.ProductPatch) (models.ProductStock, error) {
+	ctx, span := r.tracer.Start(ctx, "PatchProduct")
 	defer span.End()
 
-	return r.db.WithContext(ctx).Save(&product).Error
+	var product models.ProductStock
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("product_id = ?", productID).First(&product).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+
+		if !patch.AnyVersion && product.Version != patch.Version {
+			return fmt.Errorf("%w: expected version %d, current is %d", ErrVersionConflict, patch.Version, product.Version)
+		}
+
+		updates := map[string]interface{}{}
+		if patch.Name != nil {
+			product.Name = *patch.Name
+			updates["name"] = product.Name
+		}
+		if patch.Description != nil {
+			product.Description = *patch.Description
+			updates["description"] = product.Description
+		}
+		if patch.Attributes != nil {
+			attributes, err := mergeAttributes(tx, product, patch.Attributes)
+			if err != nil {
+				return err
+			}
+			product.Attributes = attributes
+			updates["attributes"] = attributes
+		}
+		if patch.Price != nil {
+			if err := checkCurrencyChange(tx, product, patch.Price.Currency); err != nil {
+				return err
+			}
+			product.SetUnitPrice(*patch.Price)
+			updates["price_minor_units"] = product.UnitPrice.MinorUnits
+			updates["price_currency"] = product.UnitPrice.Currency
+			updates["price"] = product.Price
+			if product.ParentID != nil {
+				product.SetUnitPriceOverride(&patch.Price.MinorUnits)
+				updates["price_override_minor_units"] = product.UnitPriceOverride
+				updates["price_override"] = product.PriceOverride
+			}
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+		product.Version++
+		updates["version"] = product.Version
+
+		if err := tx.Model(&product).Updates(updates).Error; err != nil {
+			return err
+		}
+		if patch.Price == nil || product.ParentID != nil {
+			return nil
+		}
+		return followParentPrice(tx, product)
+	})
+	return product, err
+}
+
+// followParentPrice copies a parent's price to its variants without a price override.
+func followParentPrice(tx *gorm.DB, parent models.ProductStock) error {
+	return tx.Model(&models.ProductStock{}).
+		Where("parent_id = ? AND price_override_minor_units IS NULL", parent.ProductID).
+		Updates(map[string]interface{}{
+			"price_minor_units": parent.UnitPrice.MinorUnits,
+			"price_currency":    parent.UnitPrice.Currency,
+			"price":             parent.Price,
+		}).Error
 }
 
-func (r *postgresRepository) DeleteProduct(ctx context.Context, productID string) error {
-	ctx, span := r.tracer.Start(ctx, "DeleteProduct")
+// AdjustStock adds delta, which may be negative, to a product's available stock. It is the
+// only path besides reservations that changes quantities, and it never lets available stock
+// drop below zero.
+func (r *postgresRepository) AdjustStock(ctx context.Context, productID string, delta int32) (models.ProductStock, error) {
+	ctx, span := r.tracer.Start(ctx, "AdjustStock")
 	defer span.End()
 
-	return r.db.WithContext(ctx).Delete(&models.ProductStock{}, "product_id = ?", productID).Error
+	var product models.ProductStock
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stocks, err := lockStocks(tx, []string{productID})
+		if err != nil {
+			return err
+		}
+		stock, ok := stocks[productID]
+		if !ok {
+			return ErrProductNotFound
+		}
+		parents, err := parentsWithVariants(tx, []string{productID})
+		if err != nil {
+			return err
+		}
+		if parents[productID] {
+			return ErrProductHasVariants
+		}
+		bundles, err := loadBundleComponents(tx, []string{productID})
+		if err != nil {
+			return err
+		}
+		if bundles[productID] != nil {
+			return ErrProductIsBundle
+		}
+		if stock.Quantity+delta < 0 {
+			return fmt.Errorf("%w: %d available", ErrNegativeStock, stock.Quantity)
+		}
+
+		if err := applyStockDeltas(tx, []stockDelta{{ProductID: productID, Quantity: delta}}); err != nil {
+			return err
+		}
+		return tx.Where("product_id = ?", productID).First(&product).Error
+	})
+	return product, err
 }
 
-func (r *postgresRepository) ReserveStock(ctx context.Context, orderID string, productID string, quantity int32) error {
-	ctx, span := r.tracer.Start(ctx, "ReserveStock")
+// ArchiveProduct is the soft delete: the product and its variants disappear from listings
+// and can no longer be reserved, but their rows stay so open reservations can still be
+// released. Archiving an archived product is a no-op.
+func (r *postgresRepository) ArchiveProduct(ctx context.Context, productID string) error {
+	ctx, span := r.tracer.Start(ctx, "ArchiveProduct")
 	defer span.End()
 
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. Check Idempotency
-		var record models.IdempotencyRecord
-		if err := tx.Where("order_id = ?", orderID).First(&record).Error; err == nil {
-			return nil // Already processed
+		var product models.ProductStock
+		if err := forUpdate(tx).Where("product_id = ?", productID).First(&product).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+		if product.ArchivedAt != nil {
+			return nil
 		}
+		return tx.Model(&models.ProductStock{}).
+			Where("(product_id = ? OR parent_id = ?) AND archived_at IS NULL", productID, productID).
+			Updates(map[string]interface{}{"archived_at": time.Now(), "version": gorm.Expr("version + 1")}).Error
+	})
+}
 
-		// 2. Lock the product row (Pessimistic Locking)
-		var stock models.ProductStock
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", productID).First(&stock).Error; err != nil {
+// RestoreProduct brings an archived product back, together with the variants that were
+// archived with it. A variant cannot be restored while its parent is archived.
+func (r *postgresRepository) RestoreProduct(ctx context.Context, productID string) (models.ProductStock, error) {
+	ctx, span := r.tracer.Start(ctx, "RestoreProduct")
+	defer span.End()
+
+	var product models.ProductStock
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("product_id = ?", productID).First(&product).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errors.New("product not found")
+				return ErrProductNotFound
 			}
 			return err
 		}
+		if product.ArchivedAt == nil {
+			return nil
+		}
+		if product.ParentID != nil {
+			var parent models.ProductStock
+			if err := tx.Select("archived_at").Where("product_id = ?", *product.ParentID).First(&parent).Error; err != nil {
+				return err
+			}
+			if parent.ArchivedAt != nil {
+				return fmt.Errorf("parent %w", ErrProductArchived)
+			}
+		}
 
-		// 3. Check Availability
-		if stock.Quantity < quantity {
-			return errors.New("insufficient stock")
+		// Variants archived on their own before the parent stay archived
+		if err := tx.Model(&models.ProductStock{}).
+			Where("product_id = ? OR (parent_id = ? AND archived_at = ?)", productID, productID, *product.ArchivedAt).
+			Updates(map[string]interface{}{"archived_at": nil, "version": gorm.Expr("version + 1")}).Error; err != nil {
+			return err
+		}
+		product.ArchivedAt = nil
+		product.Version++
+		return nil
+	})
+	return product, err
+}
+
+// PurgeProduct permanently deletes an archived product and its variants, and returns the
+// images deleted with them so the caller can remove their files. It is refused while any of
+// them is still held by a pending or confirmed reservation, since either could still be
+// released back to stock.
+func (r *postgresRepository) PurgeProduct(ctx context.Context, productID string) ([]models.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "PurgeProduct")
+	defer span.End()
+
+	var images []models.ProductImage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var productIDs []string
+		if err := tx.Model(&models.ProductStock{}).
+			Where("product_id = ? OR parent_id = ?", productID, productID).
+			Pluck("product_id", &productIDs).Error; err != nil {
+			return err
+		}
+		stocks, err := lockStocks(tx, productIDs)
+		if err != nil {
+			return err
+		}
+		product, ok := stocks[productID]
+		if !ok {
+			return ErrProductNotFound
+		}
+		if product.ArchivedAt == nil {
+			return ErrProductNotArchived
 		}
 
-		// 4. Deduct
-		stock.Quantity -= quantity
-		if err := tx.Save(&stock).Error; err != nil {
+		// Bundles reserved with one of them hold it too
+		var open int64
+		if err := tx.Model(&models.Reservation{}).
+			Where("status IN ?", []models.ReservationStatus{models.ReservationPending, models.ReservationConfirmed}).
+			Where(`(product_id IN ? OR EXISTS (
+				SELECT 1 FROM reserved_components AS h
+				WHERE h.order_id = reservations.order_id AND h.bundle_id = reservations.product_id AND h.component_id IN ?))`, productIDs, productIDs).
+			Count(&open).Error; err != nil {
 			return err
 		}
+		if open > 0 {
+			return ErrOpenReservations
+		}
+		var uses int64
+		if err := tx.Model(&models.BundleComponent{}).Where("component_id IN ?", productIDs).Count(&uses).Error; err != nil {
+			return err
+		}
+		if uses > 0 {
+			return ErrBundleComponent
+		}
 
-		// 5. Record Idempotency
-		return tx.Create(&models.IdempotencyRecord{OrderID: orderID}).Error
+		// Image rows cascade with the products; their files are the caller's to delete
+		if err := tx.Where("product_id IN ?", productIDs).Find(&images).Error; err != nil {
+			return err
+		}
+
+		// Variants first: the parent link restricts deletes
+		if err := tx.Delete(&models.ProductStock{}, "parent_id = ?", productID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.ProductStock{}, "product_id = ?", productID).Error
 	})
+	return images, err
 }
 
-func (r *postgresRepository) ReleaseStock(ctx context.Context, orderID string, productID string, quantity int32) error {
-	ctx, span := r.tracer.Start(ctx, "ReleaseStock")
+func (r *postgresRepository) ReserveStock(ctx context.Context, orderID string, productID string, quantity int32, expiresAt time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "ReserveStock")
 	defer span.End()
 
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. Check if we actually have a reservation (Idempotency check for release)
+		// 1. Check Idempotency
+		fingerprint := requestFingerprint([]models.BatchItem{{ProductID: productID, Quantity: quantity}}, false)
 		var record models.IdempotencyRecord
-		if err := tx.Where("order_id = ?", orderID).First(&record).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil // Nothing to release or already released
-			}
+		if err := tx.Where("order_id = ?", orderID).First(&record).Error; err == nil {
+			return checkReplay(tx, record, fingerprint) // Already processed
+		}
+
+		// 2. Lock the product row, and a bundle's components with it (Pessimistic Locking)
+		stocks, bundles, err := lockWithComponents(tx, []string{productID})
+		if err != nil {
+			return err
+		}
+		stock, ok := stocks[productID]
+		if !ok {
+			return ErrProductNotFound
+		}
+
+		// 3. Check Availability
+		if stock.ArchivedAt != nil {
+			return ErrProductArchived
+		}
+		parents, err := parentsWithVariants(tx, []string{productID})
+		if err != nil {
 			return err
 		}
+		if parents[productID] {
+			return ErrProductHasVariants
+		}
+		components := bundles[productID]
+		available := stock.Quantity
+		if components != nil {
+			if err := checkComponents(components, stocks); err != nil {
+				return err
+			}
+			available = bundleAvailable(components, stocks)
+		}
+		if available < quantity {
+			return errors.New("insufficient stock")
+		}
 
-		// 2. Lock and Update
-		var stock models.ProductStock
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", productID).First(&stock).Error; err != nil {
+		// 4. Move from available to reserved; a bundle holds its components instead
+		deltas, held := holdStock(orderID, productID, quantity, components)
+		if err := applyStockDeltas(tx, deltas); err != nil {
 			return err
 		}
+		if len(held) > 0 {
+			if err := tx.Create(&held).Error; err != nil {
+				return err
+			}
+		}
 
-		stock.Quantity += quantity
-		if err := tx.Save(&stock).Error; err != nil {
+		// 5. Record the pending reservation line at the price in effect now
+		if err := applyPriceSchedules(tx, time.Now(), &stock); err != nil {
+			return err
+		}
+		if err := tx.Create(&models.Reservation{OrderID: orderID, ProductID: productID, Quantity: quantity, Requested: quantity, UnitPrice: stock.UnitPrice, Status: models.ReservationPending, ExpiresAt: expiresAt}).Error; err != nil {
 			return err
 		}
 
-		// 3. Remove Idempotency record (so it can be re-reserved if needed, or mark as cancelled)
-		return tx.Delete(&record).Error
+		// 6. Record Idempotency
+		return tx.Create(&models.IdempotencyRecord{OrderID: orderID, RequestHash: fingerprint}).Error
 	})
 }
 
-func (r *postgresRepository) BatchReserveStock(ctx context.Context, orderID string, items []models.BatchItem) error {
+func (r *postgresRepository) ReleaseStock(ctx context.Context, orderID string, productID string) (int32, error) {
+	ctx, span := r.tracer.Start(ctx, "ReleaseStock")
+	defer span.End()
+
+	var released int32
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Restore exactly what the ledger holds for this product, pending or confirmed
+		results, err := releaseLines(tx, "order_id = ? AND product_id = ? AND status IN ?",
+			orderID, productID, []models.ReservationStatus{models.ReservationPending, models.ReservationConfirmed})
+		for _, result := range results {
+			released += result.Granted
+		}
+		return err
+	})
+	return released, err
+}
+
+// BatchReserveStock reserves every item or nothing. With allowPartial each line is granted
+// whatever is available up to the requested quantity, and the ledger records only the grant.
+// Per-item results are returned even when the reservation is rejected.
+//
+// Duplicate product lines are merged and all rows are locked in one query sorted by product
+// ID, so concurrent orders always acquire locks in the same order and cannot deadlock.
+func (r *postgresRepository) BatchReserveStock(ctx context.Context, orderID string, items []models.BatchItem, expiresAt time.Time, allowPartial bool) ([]models.BatchItemResult, error) {
 	ctx, span := r.tracer.Start(ctx, "BatchReserveStock")
 	defer span.End()
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	if err := checkQuantities(items); err != nil {
+		return nil, err
+	}
+	var results []models.BatchItemResult
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 1. Check Idempotency
+		fingerprint := requestFingerprint(items, allowPartial)
 		var record models.IdempotencyRecord
 		if err := tx.Where("order_id = ?", orderID).First(&record).Error; err == nil {
-			return nil // Already processed
+			// Already processed: answer from the ledger
+			if err := checkReplay(tx, record, fingerprint); err != nil {
+				return err
+			}
+			lines, err := lockLines(tx, "order_id = ?", orderID)
+			results = toItemResults(lines)
+			return err
 		}
 
-		for _, item := range items {
-			// 2. Lock and Check Availability
-			var stock models.ProductStock
-			if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", item.ProductID).First(&stock).Error; err != nil {
-				if errors.Is(err, gorm.ErrRecordNotFound) {
-					return fmt.Errorf("product %s not found", item.ProductID)
+		// 2. Lock every product, and the components of bundles, in one sorted round-trip
+		merged := mergeItems(items)
+		stocks, bundles, err := lockWithComponents(tx, itemProductIDs(merged))
+		if err != nil {
+			return err
+		}
+		parents, err := parentsWithVariants(tx, itemProductIDs(merged))
+		if err != nil {
+			return err
+		}
+		// Lines record the price in effect now; the rows themselves are updated through deltas
+		priced := make([]models.ProductStock, 0, len(stocks))
+		for _, stock := range stocks {
+			priced = append(priced, stock)
+		}
+		if err := applyPriceSchedules(tx, time.Now(), productPointers(priced)...); err != nil {
+			return err
+		}
+		for _, stock := range priced {
+			stocks[stock.ProductID] = stock
+		}
+
+		// 3. Check Availability
+		var granted int32
+		var rejection error
+		var deltas []stockDelta
+		var lines []models.Reservation
+		var held []models.ReservedComponent
+		for _, item := range merged {
+			result := models.BatchItemResult{ProductID: item.ProductID, Status: models.ItemOK, Requested: item.Quantity}
+
+			stock, ok := stocks[item.ProductID]
+			if !ok {
+				result.Status = models.ItemNotFound
+				results = append(results, result)
+				if rejection == nil {
+					rejection = fmt.Errorf("product %s not found", item.ProductID)
+				}
+				continue
+			}
+			if stock.ArchivedAt != nil {
+				result.Status = models.ItemInactive
+				results = append(results, result)
+				if rejection == nil {
+					rejection = fmt.Errorf("product %s is archived", item.ProductID)
+				}
+				continue
+			}
+			if parents[item.ProductID] {
+				// Stock is held by the variants; the caller has to pick one
+				result.Status = models.ItemHasVariants
+				results = append(results, result)
+				if rejection == nil {
+					rejection = fmt.Errorf("product %s has variants; reserve one of its SKUs", item.ProductID)
+				}
+				continue
+			}
+
+			components := bundles[item.ProductID]
+			if err := checkComponents(components, stocks); err != nil {
+				result.Status = models.ItemInactive
+				results = append(results, result)
+				if rejection == nil {
+					rejection = fmt.Errorf("bundle %s: %w", item.ProductID, err)
+				}
+				continue
+			}
+
+			// Bundles sharing components with other lines see what those lines already took
+			result.Available = stock.Quantity
+			if components != nil {
+				result.Available = bundleAvailable(components, stocks)
+			}
+			result.Granted = item.Quantity
+			if result.Available < item.Quantity {
+				result.Status = models.ItemInsufficient
+				result.Granted = max(result.Available, 0)
+				if rejection == nil {
+					rejection = fmt.Errorf("insufficient stock for product %s", item.ProductID)
 				}
-				return err
 			}
+			if !allowPartial && result.Status != models.ItemOK {
+				result.Granted = 0
+			}
+			results = append(results, result)
+			if result.Granted == 0 {
+				continue
+			}
+			granted += result.Granted
 
-			if stock.Quantity < item.Quantity {
-				return fmt.Errorf("insufficient stock for product %s", item.ProductID)
+			lineDeltas, lineHeld := holdStock(orderID, item.ProductID, result.Granted, components)
+			for _, delta := range lineDeltas {
+				stock := stocks[delta.ProductID]
+				stock.Quantity += delta.Quantity
+				stocks[delta.ProductID] = stock
 			}
+			deltas = append(deltas, lineDeltas...)
+			held = append(held, lineHeld...)
+			lines = append(lines, models.Reservation{OrderID: orderID, ProductID: item.ProductID, Quantity: result.Granted, Requested: item.Quantity, UnitPrice: stock.UnitPrice, Status: models.ReservationPending, ExpiresAt: expiresAt})
+		}
+
+		if !allowPartial && rejection != nil {
+			return rejection
+		}
+		if allowPartial && granted == 0 && len(items) > 0 {
+			return errors.New("insufficient stock for every item")
+		}
 
-			// 3. Deduct
-			stock.Quantity -= item.Quantity
-			if err := tx.Save(&stock).Error; err != nil {
+		// 4. Move from available to reserved and record the pending lines
+		if err := applyStockDeltas(tx, deltas); err != nil {
+			return err
+		}
+		if len(lines) > 0 {
+			if err := tx.Create(&lines).Error; err != nil {
+				return err
+			}
+		}
+		if len(held) > 0 {
+			if err := tx.Create(&held).Error; err != nil {
 				return err
 			}
 		}
 
-		// 4. Record Idempotency
-		return tx.Create(&models.IdempotencyRecord{OrderID: orderID}).Error
+		// 5. Record Idempotency
+		return tx.Create(&models.IdempotencyRecord{OrderID: orderID, RequestHash: fingerprint}).Error
 	})
+	if err != nil {
+		// The transaction rolled back, so nothing was granted
+		for i := range results {
+			results[i].Granted = 0
+		}
+	}
+	return results, err
 }
 
-func (r *postgresRepository) BatchReleaseStock(ctx context.Context, orderID string, items []models.BatchItem) error {
+func (r *postgresRepository) BatchReleaseStock(ctx context.Context, orderID string) ([]models.BatchItemResult, error) {
 	ctx, span := r.tracer.Start(ctx, "BatchReleaseStock")
 	defer span.End()
 
+	var released []models.BatchItemResult
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Restore every line the order still holds, pending or confirmed
+		results, err := releaseLines(tx, "order_id = ? AND status IN ?",
+			orderID, []models.ReservationStatus{models.ReservationPending, models.ReservationConfirmed})
+		released = results
+		return err
+	})
+	return released, err
+}
+
+func (r *postgresRepository) ConfirmReservation(ctx context.Context, orderID string) error {
+	ctx, span := r.tracer.Start(ctx, "ConfirmReservation")
+	defer span.End()
+
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. Check Idempotency
-		var record models.IdempotencyRecord
-		if err := tx.Where("order_id = ?", orderID).First(&record).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil // Nothing to release
-			}
+		lines, err := lockLines(tx, "order_id = ?", orderID)
+		if err != nil {
 			return err
 		}
+		if len(lines) == 0 {
+			return ErrReservationNotFound
+		}
 
-		for _, item := range items {
-			// 2. Lock and Update
-			var stock models.ProductStock
-			if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", item.ProductID).First(&stock).Error; err != nil {
-				return err
-			}
+		components, err := loadReservedComponents(tx, lines)
+		if err != nil {
+			return err
+		}
 
-			stock.Quantity += item.Quantity
-			if err := tx.Save(&stock).Error; err != nil {
-				return err
+		held := 0
+		var deltas []stockDelta
+		for _, line := range lines {
+			switch line.Status {
+			case models.ReservationPending:
+				// Reserved stock becomes a permanent deduction, of the components for a bundle
+				if parts := components[lineKey{OrderID: line.OrderID, ProductID: line.ProductID}]; len(parts) > 0 {
+					for _, part := range parts {
+						deltas = append(deltas, stockDelta{ProductID: part.ComponentID, Reserved: -part.Quantity})
+					}
+				} else {
+					deltas = append(deltas, stockDelta{ProductID: line.ProductID, Reserved: -line.Quantity})
+				}
+				now := time.Now()
+				line.Status = models.ReservationConfirmed
+				line.ConfirmedAt = &now
+				if err := tx.Save(&line).Error; err != nil {
+					return err
+				}
+				held++
+			case models.ReservationConfirmed:
+				held++ // Replayed confirmation
 			}
 		}
 
-		// 3. Remove Idempotency record
-		return tx.Delete(&record).Error
+		if held == 0 {
+			return ErrReservationReleased
+		}
+		productIDs := make([]string, 0, len(deltas))
+		for _, delta := range deltas {
+			productIDs = append(productIDs, delta.ProductID)
+		}
+		if _, err := lockStocks(tx, productIDs); err != nil {
+			return err
+		}
+		return applyStockDeltas(tx, deltas)
+	})
+}
+
+func (r *postgresRepository) CancelReservation(ctx context.Context, orderID string) ([]models.BatchItemResult, error) {
+	ctx, span := r.tracer.Start(ctx, "CancelReservation")
+	defer span.End()
+
+	var released []models.BatchItemResult
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var confirmed int64
+		if err := tx.Model(&models.Reservation{}).
+			Where("order_id = ? AND status = ?", orderID, models.ReservationConfirmed).
+			Count(&confirmed).Error; err != nil {
+			return err
+		}
+		if confirmed > 0 {
+			return ErrReservationConfirmed
+		}
+
+		results, err := releaseLines(tx, "order_id = ? AND status = ?", orderID, models.ReservationPending)
+		released = results
+		return err
 	})
+	return released, err
+}
+
+// ExpireReservation releases the pending lines of an order that expired before now. Confirmed
+// and already released lines are left alone, so a fulfilled order keeps its stock.
+func (r *postgresRepository) ExpireReservation(ctx context.Context, orderID string, now time.Time) ([]models.BatchItemResult, error) {
+	ctx, span := r.tracer.Start(ctx, "ExpireReservation")
+	defer span.End()
+
+	var released []models.BatchItemResult
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Expiry is re-checked under the lock so a confirmation that raced the sweeper wins
+		results, err := releaseLines(tx, "order_id = ? AND status = ? AND expires_at < ?", orderID, models.ReservationPending, now)
+		released = results
+		return err
+	})
+	return released, err
+}
+
+// ListExpiredReservations returns up to limit orders holding pending lines that expired before now.
+func (r *postgresRepository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListExpiredReservations")
+	defer span.End()
+
+	var orderIDs []string
+	err := r.db.WithContext(ctx).Model(&models.Reservation{}).
+		Distinct("order_id").
+		Where("status = ? AND expires_at < ?", models.ReservationPending, now).
+		Order("order_id").
+		Limit(limit).
+		Pluck("order_id", &orderIDs).Error
+	return orderIDs, err
+}
+
+// PurgeCompletedReservations deletes up to limit idempotency records created before the cutoff,
+// together with their ledger lines, and reports how many records and lines were removed.
+// An order is only eligible once every line is settled (confirmed or released) and has not
+// changed since the cutoff, so stock that is still held keeps its ledger and its replay guard.
+// Records locked by a concurrent call are skipped rather than waited on.
+func (r *postgresRepository) PurgeCompletedReservations(ctx context.Context, before time.Time, limit int) (int64, int64, error) {
+	ctx, span := r.tracer.Start(ctx, "PurgeCompletedReservations")
+	defer span.End()
+
+	var records, lines int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var orderIDs []string
+		if err := tx.Raw(`SELECT i.order_id FROM idempotency_records AS i
+			WHERE i.created_at < ?
+			AND NOT EXISTS (
+				SELECT 1 FROM reservations AS r
+				WHERE r.order_id = i.order_id AND (r.status = ? OR r.updated_at >= ?)
+			)
+			ORDER BY i.created_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED`, before, models.ReservationPending, before, limit).
+			Scan(&orderIDs).Error; err != nil {
+			return err
+		}
+		if len(orderIDs) == 0 {
+			return nil
+		}
+
+		// The status guard repeats the eligibility check in case a line changed meanwhile
+		deleted := tx.Where("order_id IN ? AND status <> ?", orderIDs, models.ReservationPending).Delete(&models.Reservation{})
+		if deleted.Error != nil {
+			return deleted.Error
+		}
+		lines = deleted.RowsAffected
+
+		// What bundle lines held goes with them
+		if err := tx.Where(`order_id IN ? AND NOT EXISTS (
+			SELECT 1 FROM reservations AS r
+			WHERE r.order_id = reserved_components.order_id AND r.product_id = reserved_components.bundle_id)`, orderIDs).
+			Delete(&models.ReservedComponent{}).Error; err != nil {
+			return err
+		}
+
+		deleted = tx.Where("order_id IN ?", orderIDs).Delete(&models.IdempotencyRecord{})
+		if deleted.Error != nil {
+			return deleted.Error
+		}
+		records = deleted.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return records, lines, nil
+}
+
+func (r *postgresRepository) GetReservation(ctx context.Context, orderID string) ([]models.Reservation, error) {
+	ctx, span := r.tracer.Start(ctx, "GetReservation")
+	defer span.End()
+
+	var lines []models.Reservation
+	if err := r.db.WithContext(ctx).Where("order_id = ?", orderID).Order("product_id").Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, ErrReservationNotFound
+	}
+	return lines, nil
+}
+
+// checkReplay decides how a reserve call for an already-recorded order is answered.
+// A payload that does not match the recorded fingerprint is a conflict, not a replay.
+func checkReplay(tx *gorm.DB, record models.IdempotencyRecord, fingerprint string) error {
+	if record.RequestHash != "" && record.RequestHash != fingerprint {
+		return ErrIdempotencyConflict
+	}
+
+	var active int64
+	if err := tx.Model(&models.Reservation{}).
+		Where("order_id = ? AND status <> ?", record.OrderID, models.ReservationReleased).
+		Count(&active).Error; err != nil {
+		return err
+	}
+	if active == 0 {
+		return ErrReservationReleased
+	}
+	return nil
+}
+
+// lockLines loads the matching ledger lines in a stable order and locks them.
+func lockLines(tx *gorm.DB, query string, args ...interface{}) ([]models.Reservation, error) {
+	var lines []models.Reservation
+	err := forUpdate(tx).
+		Where(query, args...).
+		Order("product_id").
+		Find(&lines).Error
+	return lines, err
+}
+
+// releaseLines returns the stock held by every matching ledger line and marks the lines released.
+// It is the single release path shared by releases, cancellations and expiry.
+func releaseLines(tx *gorm.DB, query string, args ...interface{}) ([]models.BatchItemResult, error) {
+	lines, err := lockLines(tx, query, args...)
+	if err != nil || len(lines) == 0 {
+		return nil, err
+	}
+
+	components, err := loadReservedComponents(tx, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	var productIDs []string
+	var keys [][]interface{}
+	for _, line := range lines {
+		productIDs = append(productIDs, line.ProductID)
+		keys = append(keys, []interface{}{line.OrderID, line.ProductID})
+		for _, part := range components[lineKey{OrderID: line.OrderID, ProductID: line.ProductID}] {
+			productIDs = append(productIDs, part.ComponentID)
+		}
+	}
+	stocks, err := lockStocks(tx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// A bundle line returns what it holds of each component rather than stock of its own
+	var deltas []stockDelta
+	for _, line := range lines {
+		parts := components[lineKey{OrderID: line.OrderID, ProductID: line.ProductID}]
+		if len(parts) == 0 {
+			parts = []models.ReservedComponent{{ComponentID: line.ProductID, Quantity: line.Quantity}}
+		}
+		for _, part := range parts {
+			delta := stockDelta{ProductID: part.ComponentID, Quantity: part.Quantity}
+			if line.Status == models.ReservationPending {
+				delta.Reserved = -part.Quantity
+			}
+			deltas = append(deltas, delta)
+
+			stock := stocks[part.ComponentID]
+			stock.Quantity += part.Quantity
+			stocks[part.ComponentID] = stock
+		}
+	}
+
+	var results []models.BatchItemResult
+	for _, line := range lines {
+		available := stocks[line.ProductID].Quantity
+		if parts := components[lineKey{OrderID: line.OrderID, ProductID: line.ProductID}]; len(parts) > 0 {
+			available = bundleAvailable(perUnit(line, parts), stocks)
+		}
+		results = append(results, models.BatchItemResult{
+			ProductID: line.ProductID,
+			Status:    models.ItemOK,
+			Requested: line.Quantity,
+			Granted:   line.Quantity,
+			Available: available,
+		})
+	}
+
+	if err := applyStockDeltas(tx, deltas); err != nil {
+		return nil, err
+	}
+	if err := tx.Model(&models.Reservation{}).
+		Where("(order_id, product_id) IN ?", keys).
+		Updates(map[string]interface{}{"status": models.ReservationReleased, "released_at": time.Now()}).Error; err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// forUpdate makes the next query take row locks (SELECT ... FOR UPDATE).
+func forUpdate(tx *gorm.DB) *gorm.DB {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"})
+}
+
+// lockStocks locks the given products in one query, always in product ID order, and returns
+// them keyed by ID. Products that do not exist are simply absent from the map.
+func lockStocks(tx *gorm.DB, productIDs []string) (map[string]models.ProductStock, error) {
+	stocks := make(map[string]models.ProductStock, len(productIDs))
+	if len(productIDs) == 0 {
+		return stocks, nil
+	}
+
+	var rows []models.ProductStock
+	if err := forUpdate(tx).Where("product_id IN ?", productIDs).Order("product_id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		stocks[row.ProductID] = row
+	}
+	return stocks, nil
+}
+
+// stockDelta is a signed change to one product's available and reserved stock.
+type stockDelta struct {
+	ProductID string
+	Quantity  int32
+	Reserved  int32
+}
+
+// applyStockDeltas applies every delta in a single UPDATE ... FROM (VALUES ...) statement.
+// Callers must already hold the row locks (see lockStocks).
+func applyStockDeltas(tx *gorm.DB, deltas []stockDelta) error {
+	// A row joined to several values would only be updated once
+	deltas = mergeDeltas(deltas)
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(deltas))
+	args := []interface{}{time.Now()}
+	for _, d := range deltas {
+		values = append(values, "(?, ?::integer, ?::integer)")
+		args = append(args, d.ProductID, d.Quantity, d.Reserved)
+	}
+
+	sql := `UPDATE product_stocks AS p
+		SET quantity = p.quantity + v.quantity, reserved = p.reserved + v.reserved, updated_at = ?
+		FROM (VALUES ` + strings.Join(values, ", ") + `) AS v(product_id, quantity, reserved)
+		WHERE p.product_id = v.product_id`
+	return tx.Exec(sql, args...).Error
+}
+
+// mergeDeltas sums the deltas of each product, e.g. a component held directly and through a
+// bundle, and sorts the result by product ID.
+func mergeDeltas(deltas []stockDelta) []stockDelta {
+	totals := make(map[string]stockDelta, len(deltas))
+	for _, delta := range deltas {
+		total := totals[delta.ProductID]
+		total.ProductID = delta.ProductID
+		total.Quantity += delta.Quantity
+		total.Reserved += delta.Reserved
+		totals[delta.ProductID] = total
+	}
+
+	merged := make([]stockDelta, 0, len(totals))
+	for _, total := range totals {
+		merged = append(merged, total)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].ProductID < merged[j].ProductID })
+	return merged
+}
+
+// checkQuantities rejects lines that would not take stock, before any row is locked: a
+// negative line would add stock instead. Merged totals are checked too, since summing
+// duplicate lines can overflow.
+func checkQuantities(items []models.BatchItem) error {
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return ErrInvalidQuantity
+		}
+	}
+	for _, item := range mergeItems(items) {
+		if item.Quantity <= 0 {
+			return ErrInvalidQuantity
+		}
+	}
+	return nil
+}
+
+// mergeItems sums duplicate product lines and sorts the result by product ID.
+func mergeItems(items []models.BatchItem) []models.BatchItem {
+	totals := make(map[string]int32, len(items))
+	for _, item := range items {
+		totals[item.ProductID] += item.Quantity
+	}
+
+	merged := make([]models.BatchItem, 0, len(totals))
+	for productID, quantity := range totals {
+		merged = append(merged, models.BatchItem{ProductID: productID, Quantity: quantity})
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].ProductID < merged[j].ProductID })
+	return merged
+}
+
+// requestFingerprint hashes what a reserve call asks for. Items are merged and sorted first,
+// so the same order expressed with split or reordered lines hashes identically. The TTL is
+// left out: it only moves the expiry and a retried call recomputes it anyway.
+func requestFingerprint(items []models.BatchItem, allowPartial bool) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "partial=%t\n", allowPartial)
+	for _, item := range mergeItems(items) {
+		fmt.Fprintf(h, "%d:%s=%d\n", len(item.ProductID), item.ProductID, item.Quantity)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func itemProductIDs(items []models.BatchItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+	}
+	return ids
+}
+
+func toItemResults(lines []models.Reservation) []models.BatchItemResult {
+	var results []models.BatchItemResult
+	for _, line := range lines {
+		status := models.ItemOK
+		if line.Quantity < line.Requested {
+			status = models.ItemInsufficient
+		}
+		results = append(results, models.BatchItemResult{ProductID: line.ProductID, Status: status, Requested: line.Requested, Granted: line.Quantity})
+	}
+	return results
 }
 
 func (r *postgresRepository) RestockItems(ctx context.Context, productID string, quantity int32) error {
@@ -218,21 +1072,34 @@ func (r *postgresRepository) RestockItems(ctx context.Context, productID string,
 
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Lock the product row
-		var stock models.ProductStock
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", productID).First(&stock).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errors.New("product not found")
-			}
+		stocks, err := lockStocks(tx, []string{productID})
+		if err != nil {
 			return err
 		}
-
-		// Update
-		stock.Quantity += quantity
-		if err := tx.Save(&stock).Error; err != nil {
+		stock, ok := stocks[productID]
+		if !ok {
+			return ErrProductNotFound
+		}
+		if stock.ArchivedAt != nil {
+			return ErrProductArchived
+		}
+		parents, err := parentsWithVariants(tx, []string{productID})
+		if err != nil {
 			return err
 		}
+		if parents[productID] {
+			return ErrProductHasVariants
+		}
+		bundles, err := loadBundleComponents(tx, []string{productID})
+		if err != nil {
+			return err
+		}
+		if bundles[productID] != nil {
+			return ErrProductIsBundle
+		}
 
-		return nil
+		// Update
+		return applyStockDeltas(tx, []stockDelta{{ProductID: productID, Quantity: quantity}})
 	})
 }
 
@@ -242,7 +1109,13 @@ func (r *postgresRepository) GetProduct(ctx context.Context, productID string) (
 
 	var product models.ProductStock
 	err := r.db.WithContext(ctx).Where("product_id = ?", productID).First(&product).Error
-	return product, err
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return product, ErrProductNotFound
+	}
+	if err != nil {
+		return product, err
+	}
+	return product, hydrateProducts(r.db.WithContext(ctx), time.Now(), &product)
 }
 
 func (r *postgresRepository) GetStock(ctx context.Context, productID string) (int32, error) {
@@ -250,6 +1123,10 @@ func (r *postgresRepository) GetStock(ctx context.Context, productID string) (in
 	defer span.End()
 
 	var stock models.ProductStock
-	err := r.db.WithContext(ctx).Where("product_id = ?", productID).First(&stock).Error
+	if err := r.db.WithContext(ctx).Where("product_id = ?", productID).First(&stock).Error; err != nil {
+		return 0, err
+	}
+	// A bundle's stock is what its components allow
+	err := loadBundles(r.db.WithContext(ctx), &stock)
 	return stock.Quantity, err
 }
@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"inventory-service/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestMergeItems(t *testing.T) {
+	items := []models.BatchItem{
+		{ProductID: "PROD-003", Quantity: 1},
+		{ProductID: "PROD-001", Quantity: 2},
+		{ProductID: "PROD-003", Quantity: 4},
+	}
+
+	merged := mergeItems(items)
+
+	assert.Equal(t, []models.BatchItem{
+		{ProductID: "PROD-001", Quantity: 2},
+		{ProductID: "PROD-003", Quantity: 5},
+	}, merged)
+}
+
+func TestCheckQuantities(t *testing.T) {
+	assert.NoError(t, checkQuantities([]models.BatchItem{{ProductID: "PROD-001", Quantity: 1}, {ProductID: "PROD-001", Quantity: 2}}))
+
+	cases := map[string][]models.BatchItem{
+		"zero":     {{ProductID: "PROD-001", Quantity: 2}, {ProductID: "PROD-002", Quantity: 0}},
+		"negative": {{ProductID: "PROD-001", Quantity: -3}},
+		"offset":   {{ProductID: "PROD-001", Quantity: 5}, {ProductID: "PROD-001", Quantity: -2}},
+		"overflow": {{ProductID: "PROD-001", Quantity: math.MaxInt32}, {ProductID: "PROD-001", Quantity: 1}},
+	}
+	for name, items := range cases {
+		assert.ErrorIs(t, checkQuantities(items), ErrInvalidQuantity, name)
+	}
+}
+
+func TestReserveRejectsNonPositiveQuantities(t *testing.T) {
+	// No database: the quantity must be rejected before a transaction is opened
+	repo := NewPostgresRepository(nil)
+	expiresAt := time.Now().Add(time.Minute)
+
+	for _, quantity := range []int32{0, -1} {
+		assert.ErrorIs(t, repo.ReserveStock(context.Background(), "ORD-1", "PROD-001", quantity, expiresAt), ErrInvalidQuantity)
+
+		results, err := repo.BatchReserveStock(context.Background(), "ORD-1", []models.BatchItem{{ProductID: "PROD-001", Quantity: quantity}}, expiresAt, true)
+		assert.ErrorIs(t, err, ErrInvalidQuantity)
+		assert.Nil(t, results)
+	}
+}
+
+func TestMergeDeltas(t *testing.T) {
+	deltas := []stockDelta{
+		{ProductID: "PROD-005", Quantity: -2, Reserved: 2},
+		{ProductID: "PROD-004", Quantity: -1, Reserved: 1},
+		{ProductID: "PROD-005", Quantity: -1, Reserved: 1},
+	}
+
+	assert.Equal(t, []stockDelta{
+		{ProductID: "PROD-004", Quantity: -1, Reserved: 1},
+		{ProductID: "PROD-005", Quantity: -3, Reserved: 3},
+	}, mergeDeltas(deltas))
+}
+
+func TestBundleStock(t *testing.T) {
+	components := []models.BundleComponent{
+		{BundleID: "DESK-SETUP", ComponentID: "PROD-004", Quantity: 1},
+		{BundleID: "DESK-SETUP", ComponentID: "PROD-005", Quantity: 2},
+	}
+	stocks := map[string]models.ProductStock{
+		"PROD-004": {ProductID: "PROD-004", Quantity: 7},
+		"PROD-005": {ProductID: "PROD-005", Quantity: 9},
+	}
+
+	assert.Equal(t, int32(4), bundleAvailable(components, stocks))
+
+	deltas, held := holdStock("ORD-1", "DESK-SETUP", 3, components)
+	assert.Equal(t, []stockDelta{
+		{ProductID: "PROD-004", Quantity: -3, Reserved: 3},
+		{ProductID: "PROD-005", Quantity: -6, Reserved: 6},
+	}, deltas)
+	assert.Equal(t, components, perUnit(models.Reservation{OrderID: "ORD-1", ProductID: "DESK-SETUP", Quantity: 3}, held))
+
+	deltas, held = holdStock("ORD-1", "PROD-004", 3, nil)
+	assert.Equal(t, []stockDelta{{ProductID: "PROD-004", Quantity: -3, Reserved: 3}}, deltas)
+	assert.Empty(t, held)
+
+	archived := time.Now()
+	stocks["PROD-005"] = models.ProductStock{ProductID: "PROD-005", Quantity: 9, ArchivedAt: &archived}
+	assert.Zero(t, bundleAvailable(components, stocks))
+	assert.ErrorIs(t, checkComponents(components, stocks), ErrProductArchived)
+}
+
+func TestRequestFingerprint(t *testing.T) {
+	split := []models.BatchItem{
+		{ProductID: "PROD-002", Quantity: 1},
+		{ProductID: "PROD-001", Quantity: 2},
+		{ProductID: "PROD-002", Quantity: 3},
+	}
+	merged := []models.BatchItem{
+		{ProductID: "PROD-001", Quantity: 2},
+		{ProductID: "PROD-002", Quantity: 4},
+	}
+
+	assert.Equal(t, requestFingerprint(merged, false), requestFingerprint(split, false))
+	assert.NotEqual(t, requestFingerprint(merged, false), requestFingerprint(merged, true))
+	assert.NotEqual(t, requestFingerprint(merged, false), requestFingerprint(merged[:1], false))
+	assert.NotEqual(t,
+		requestFingerprint([]models.BatchItem{{ProductID: "PROD-001", Quantity: 2}}, false),
+		requestFingerprint([]models.BatchItem{{ProductID: "PROD-001", Quantity: 3}}, false))
+}
+
+func TestProductCursorRoundTrip(t *testing.T) {
+	updated := time.Date(2026, 3, 1, 12, 30, 0, 123456000, time.UTC)
+	product := models.ProductStock{ProductID: "PROD-007", Name: "Lamp", UnitPrice: models.Money{MinorUnits: 1999, Currency: "USD"}, Quantity: 4, UpdatedAt: updated}
+
+	cases := map[models.ProductSort]interface{}{
+		models.SortByName:      "Lamp",
+		models.SortByPrice:     int64(1999),
+		models.SortByQuantity:  int32(4),
+		models.SortByUpdatedAt: updated,
+	}
+	for sortBy, want := range cases {
+		query := models.ProductQuery{SortBy: sortBy, Descending: true}
+		token, err := encodeProductCursor(query, product)
+		assert.NoError(t, err)
+
+		query.PageToken = token
+		cursor, err := decodeProductCursor(query)
+		assert.NoError(t, err)
+		assert.Equal(t, "PROD-007", cursor.ProductID)
+
+		value, err := cursorValue(sortBy, cursor.Value)
+		assert.NoError(t, err)
+		assert.Equal(t, want, value, string(sortBy))
+	}
+}
+
+func TestProductCursorRejectsOtherSortOrders(t *testing.T) {
+	token, err := encodeProductCursor(models.ProductQuery{SortBy: models.SortByPrice}, models.ProductStock{ProductID: "PROD-001"})
+	assert.NoError(t, err)
+
+	_, err = decodeProductCursor(models.ProductQuery{SortBy: models.SortByPrice, Descending: true, PageToken: token})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	_, err = decodeProductCursor(models.ProductQuery{SortBy: models.SortByName, PageToken: token})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	_, err = decodeProductCursor(models.ProductQuery{PageToken: "not a token"})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestSearchCursor(t *testing.T) {
+	token, err := encodeSearchCursor(searchCursor{Query: "keyboard", Offset: 50})
+	assert.NoError(t, err)
+
+	offset, err := decodeSearchCursor(token, "keyboard")
+	assert.NoError(t, err)
+	assert.Equal(t, 50, offset)
+
+	_, err = decodeSearchCursor(token, "mouse")
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	offset, err = decodeSearchCursor("", "mouse")
+	assert.NoError(t, err)
+	assert.Zero(t, offset)
+}
+
+func TestCompileRule(t *testing.T) {
+	offers := models.Promotion{
+		DiscountType: models.DiscountPercent,
+		Rule: models.PromotionRule{Match: models.MatchAny, Conditions: []models.PromotionCondition{
+			{Attribute: models.RulePrice, Operator: models.OpLessThan, Value: 5000},
+			{Attribute: models.RuleQuantity, Operator: models.OpLessThan, Value: 10},
+		}},
+	}
+	sql, args, err := compileRule(offers)
+	assert.NoError(t, err)
+	assert.Equal(t, "(price_minor_units < ? OR "+availableQuantitySQL+" < ?)", sql)
+	assert.Equal(t, []interface{}{int64(5000), int64(10)}, args)
+
+	fixed := models.Promotion{DiscountType: models.DiscountFixed, DiscountCurrency: "EUR", Rule: models.PromotionRule{Match: models.MatchAll}}
+	sql, args, err = compileRule(fixed)
+	assert.NoError(t, err)
+	assert.Equal(t, "(TRUE AND price_currency = ?)", sql)
+	assert.Equal(t, []interface{}{"EUR"}, args)
+
+	invalid := models.Promotion{Rule: models.PromotionRule{Conditions: []models.PromotionCondition{
+		{Attribute: models.RuleQuantity, Operator: models.OpIn, Values: []string{"1"}},
+	}}}
+	_, _, err = compileRule(invalid)
+	assert.Error(t, err)
+}
+
+// BenchmarkLockStocks compares the single sorted lock query against one SELECT ... FOR UPDATE
+// per line. It needs a disposable Postgres, e.g.
+// INVENTORY_BENCH_DSN="host=localhost user=admin password=password123 dbname=inventory_bench port=5433 sslmode=disable"
+func BenchmarkLockStocks(b *testing.B) {
+	dsn := os.Getenv("INVENTORY_BENCH_DSN")
+	if dsn == "" {
+		b.Skip("INVENTORY_BENCH_DSN not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err := db.AutoMigrate(&models.ProductStock{}); err != nil {
+		b.Fatal(err)
+	}
+
+	const lines = 50
+	var ids []string
+	for i := 0; i < lines; i++ {
+		id := fmt.Sprintf("BENCH-%03d", i)
+		ids = append(ids, id)
+		db.Save(&models.ProductStock{ProductID: id, Name: id, Quantity: 1000})
+	}
+	b.Cleanup(func() { db.Where("product_id IN ?", ids).Delete(&models.ProductStock{}) })
+
+	b.Run("SortedSingleQuery", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			db.Transaction(func(tx *gorm.DB) error {
+				_, err := lockStocks(tx, ids)
+				return err
+			})
+		}
+	})
+
+	b.Run("PerLineQueries", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			db.Transaction(func(tx *gorm.DB) error {
+				for _, id := range ids {
+					var stock models.ProductStock
+					if err := forUpdate(tx).Where("product_id = ?", id).First(&stock).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrImageNotFound      = errors.New("image not found")
+	ErrImageOrderMismatch = errors.New("image order must list each of the product's images exactly once")
+)
+
+type MediaRepository interface {
+	CreateImage(ctx context.Context, image models.ProductImage) (models.ProductImage, error)
+	ListImages(ctx context.Context, productID string) ([]models.ProductImage, error)
+	ReorderImages(ctx context.Context, productID string, imageIDs []int64) ([]models.ProductImage, error)
+	DeleteImage(ctx context.Context, productID string, imageID int64) (models.ProductImage, error)
+}
+
+type postgresMediaRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+func NewPostgresMediaRepository(db *gorm.DB) MediaRepository {
+	return &postgresMediaRepository{
+		db:     db,
+		tracer: otel.Tracer("MediaRepository"),
+	}
+}
+
+// CreateImage appends an image to a product that is not archived.
+func (r *postgresMediaRepository) CreateImage(ctx context.Context, image models.ProductImage) (models.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "CreateImage")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the product so concurrent uploads get distinct positions
+		var product models.ProductStock
+		if err := forUpdate(tx).Where("product_id = ? AND archived_at IS NULL", image.ProductID).First(&product).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+		var count int64
+		if err := tx.Model(&models.ProductImage{}).Where("product_id = ?", image.ProductID).Count(&count).Error; err != nil {
+			return err
+		}
+		image.Position = int32(count)
+		return tx.Omit("Product").Create(&image).Error
+	})
+	return image, err
+}
+
+func (r *postgresMediaRepository) ListImages(ctx context.Context, productID string) ([]models.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "ListImages")
+	defer span.End()
+
+	var images []models.ProductImage
+	err := r.db.WithContext(ctx).Where("product_id = ?", productID).Order("position, id").Find(&images).Error
+	return images, err
+}
+
+// ReorderImages puts a product's images in the given order; imageIDs must name every image
+// of the product once.
+func (r *postgresMediaRepository) ReorderImages(ctx context.Context, productID string, imageIDs []int64) ([]models.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "ReorderImages")
+	defer span.End()
+
+	var images []models.ProductImage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("product_id = ?", productID).Order("position, id").Find(&images).Error; err != nil {
+			return err
+		}
+		if len(imageIDs) != len(images) {
+			return ErrImageOrderMismatch
+		}
+		positions := make(map[int64]int32, len(imageIDs))
+		for i, id := range imageIDs {
+			if _, seen := positions[id]; seen {
+				return ErrImageOrderMismatch
+			}
+			positions[id] = int32(i)
+		}
+		for i := range images {
+			position, ok := positions[images[i].ID]
+			if !ok {
+				return ErrImageOrderMismatch
+			}
+			if images[i].Position == position {
+				continue
+			}
+			images[i].Position = position
+			if err := tx.Model(&images[i]).Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ordered := make([]models.ProductImage, len(images))
+	for _, image := range images {
+		ordered[image.Position] = image
+	}
+	return ordered, nil
+}
+
+// DeleteImage removes one of a product's images, closes the gap it leaves in the order and
+// returns the removed row so its files can be deleted.
+func (r *postgresMediaRepository) DeleteImage(ctx context.Context, productID string, imageID int64) (models.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "DeleteImage")
+	defer span.End()
+
+	var image models.ProductImage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("id = ? AND product_id = ?", imageID, productID).First(&image).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrImageNotFound
+			}
+			return err
+		}
+		if err := tx.Delete(&image).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.ProductImage{}).
+			Where("product_id = ? AND position > ?", productID, image.Position).
+			Update("position", gorm.Expr("position - 1")).Error
+	})
+	return image, err
+}
+
+// loadImages attaches their images to the products and their loaded variants.
+func loadImages(tx *gorm.DB, products ...*models.ProductStock) error {
+	all := withVariants(products)
+	if len(all) == 0 {
+		return nil
+	}
+	productIDs := make([]string, len(all))
+	for i, product := range all {
+		productIDs[i] = product.ProductID
+	}
+	var images []models.ProductImage
+	if err := tx.Where("product_id IN ?", productIDs).Order("product_id, position, id").Find(&images).Error; err != nil {
+		return err
+	}
+	byProduct := make(map[string][]models.ProductImage)
+	for _, image := range images {
+		byProduct[image.ProductID] = append(byProduct[image.ProductID], image)
+	}
+	for _, product := range all {
+		product.Images = byProduct[product.ProductID]
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPriceScheduleNotFound = errors.New("price schedule not found")
+	ErrPriceScheduleOverlap  = errors.New("price schedule overlaps another schedule of the product")
+	ErrPriceScheduleCurrency = errors.New("price schedule must be in the product's currency")
+)
+
+// CreatePriceSchedule queues a price change for a product that is not archived. The price
+// defaults to the product's currency and must be in it.
+func (r *postgresPricingRepository) CreatePriceSchedule(ctx context.Context, schedule models.PriceSchedule) (models.PriceSchedule, error) {
+	ctx, span := r.tracer.Start(ctx, "CreatePriceSchedule")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the product so two overlapping schedules cannot both pass the check below
+		var product models.ProductStock
+		if err := forUpdate(tx).Where("product_id = ? AND archived_at IS NULL", schedule.ProductID).First(&product).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+		if schedule.UnitPrice.Currency == "" {
+			schedule.UnitPrice.Currency = product.UnitPrice.Currency
+		}
+		if schedule.UnitPrice.Currency != product.UnitPrice.Currency {
+			return ErrPriceScheduleCurrency
+		}
+
+		var overlapping int64
+		if err := tx.Model(&models.PriceSchedule{}).
+			Where("product_id = ? AND starts_at < ? AND ends_at > ?", schedule.ProductID, schedule.EndsAt, schedule.StartsAt).
+			Count(&overlapping).Error; err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return ErrPriceScheduleOverlap
+		}
+		return tx.Omit("Product").Create(&schedule).Error
+	})
+	return schedule, err
+}
+
+// CancelPriceSchedule deletes one of a product's schedules; a running one ends at once.
+func (r *postgresPricingRepository) CancelPriceSchedule(ctx context.Context, productID string, scheduleID int64) error {
+	ctx, span := r.tracer.Start(ctx, "CancelPriceSchedule")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Delete(&models.PriceSchedule{}, "id = ? AND product_id = ?", scheduleID, productID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPriceScheduleNotFound
+	}
+	return nil
+}
+
+// ListPriceSchedules returns a product's schedules in the order they start. Ended ones are
+// only included when asked for.
+func (r *postgresPricingRepository) ListPriceSchedules(ctx context.Context, productID string, includeEnded bool, at time.Time) ([]models.PriceSchedule, error) {
+	ctx, span := r.tracer.Start(ctx, "ListPriceSchedules")
+	defer span.End()
+
+	tx := r.db.WithContext(ctx).Where("product_id = ?", productID)
+	if !includeEnded {
+		tx = tx.Where("ends_at > ?", at)
+	}
+	var schedules []models.PriceSchedule
+	err := tx.Order("starts_at").Find(&schedules).Error
+	return schedules, err
+}
+
+// applyPriceSchedules replaces the price of each product, and of its loaded variants, with
+// the scheduled price running at the given time. A variant without a price override follows
+// its parent's schedule unless it has one of its own. Schedules in a currency the product is
+// no longer priced in are ignored.
+func applyPriceSchedules(tx *gorm.DB, at time.Time, products ...*models.ProductStock) error {
+	all := withVariants(products)
+	if len(all) == 0 {
+		return nil
+	}
+
+	var productIDs []string
+	for _, product := range all {
+		productIDs = append(productIDs, product.ProductID)
+		if product.ParentID != nil {
+			productIDs = append(productIDs, *product.ParentID)
+		}
+	}
+	var running []models.PriceSchedule
+	if err := tx.Where("product_id IN ? AND starts_at <= ? AND ends_at > ?", productIDs, at, at).Find(&running).Error; err != nil {
+		return err
+	}
+	if len(running) == 0 {
+		return nil
+	}
+	byProduct := make(map[string]models.PriceSchedule, len(running))
+	for _, schedule := range running {
+		byProduct[schedule.ProductID] = schedule
+	}
+
+	for _, product := range all {
+		schedule, ok := byProduct[product.ProductID]
+		if !ok && product.ParentID != nil && product.UnitPriceOverride == nil {
+			schedule, ok = byProduct[*product.ParentID]
+		}
+		if !ok || schedule.UnitPrice.Currency != product.UnitPrice.Currency {
+			continue
+		}
+		product.SetUnitPrice(schedule.UnitPrice)
+		product.PriceScheduleID = &schedule.ID
+	}
+	return nil
+}
+
+// hydrateProducts completes products read for a response: the components and derived stock
+// of bundles, scheduled prices running at the given time, and images.
+func hydrateProducts(tx *gorm.DB, at time.Time, products ...*models.ProductStock) error {
+	if err := loadBundles(tx, products...); err != nil {
+		return err
+	}
+	return hydrateCatalog(tx, at, products...)
+}
+
+// hydrateCatalog is hydrateProducts without bundle stock, for listings that must take their
+// page cursor between the two.
+func hydrateCatalog(tx *gorm.DB, at time.Time, products ...*models.ProductStock) error {
+	if err := applyPriceSchedules(tx, at, products...); err != nil {
+		return err
+	}
+	return loadImages(tx, products...)
+}
+
+// withVariants returns the products followed by their loaded variants.
+func withVariants(products []*models.ProductStock) []*models.ProductStock {
+	all := append([]*models.ProductStock(nil), products...)
+	for i := 0; i < len(all); i++ {
+		for j := range all[i].Variants {
+			all = append(all, &all[i].Variants[j])
+		}
+	}
+	return all
+}
+
+// productPointers lets a slice of products be completed in place.
+func productPointers(products []models.ProductStock) []*models.ProductStock {
+	pointers := make([]*models.ProductStock, len(products))
+	for i := range products {
+		pointers[i] = &products[i]
+	}
+	return pointers
+}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPriceListNotFound      = errors.New("price list not found")
+	ErrPriceListExists        = errors.New("price list already exists")
+	ErrPriceListEntryNotFound = errors.New("product has no price in this price list")
+	ErrExchangeRateNotFound   = errors.New("exchange rate not found")
+)
+
+type PricingRepository interface {
+	CreatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error)
+	UpdatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error)
+	DeletePriceList(ctx context.Context, priceListID string) error
+	GetPriceList(ctx context.Context, priceListID string) (models.PriceList, error)
+	FindPriceList(ctx context.Context, currency string) (models.PriceList, error)
+	ListPriceLists(ctx context.Context) ([]models.PriceList, error)
+	SetPrice(ctx context.Context, entry models.PriceListEntry) (models.PriceListEntry, error)
+	DeletePrice(ctx context.Context, priceListID string, productID string) error
+	GetPrices(ctx context.Context, priceListID string, productIDs []string) (map[string]int64, error)
+	SetExchangeRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error)
+	DeleteExchangeRate(ctx context.Context, baseCurrency string, quoteCurrency string) error
+	ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context, currency string) ([]models.ExchangeRate, error)
+	CreatePriceSchedule(ctx context.Context, schedule models.PriceSchedule) (models.PriceSchedule, error)
+	CancelPriceSchedule(ctx context.Context, productID string, scheduleID int64) error
+	ListPriceSchedules(ctx context.Context, productID string, includeEnded bool, at time.Time) ([]models.PriceSchedule, error)
+}
+
+type postgresPricingRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+func NewPostgresPricingRepository(db *gorm.DB) PricingRepository {
+	return &postgresPricingRepository{
+		db:     db,
+		tracer: otel.Tracer("PricingRepository"),
+	}
+}
+
+func (r *postgresPricingRepository) CreatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error) {
+	ctx, span := r.tracer.Start(ctx, "CreatePriceList")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&list)
+	if result.Error != nil {
+		return list, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return list, ErrPriceListExists
+	}
+	return list, nil
+}
+
+// UpdatePriceList renames a price list and changes its rounding. The currency is fixed once
+// the list exists, since its explicit prices are in that currency.
+func (r *postgresPricingRepository) UpdatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error) {
+	ctx, span := r.tracer.Start(ctx, "UpdatePriceList")
+	defer span.End()
+
+	var updated models.PriceList
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("id = ?", list.ID).First(&updated).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPriceListNotFound
+			}
+			return err
+		}
+		updated.Name = list.Name
+		updated.Rounding = list.Rounding
+		updated.RoundingIncrement = list.RoundingIncrement
+		return tx.Save(&updated).Error
+	})
+	return updated, err
+}
+
+// DeletePriceList removes a price list together with its explicit prices.
+func (r *postgresPricingRepository) DeletePriceList(ctx context.Context, priceListID string) error {
+	ctx, span := r.tracer.Start(ctx, "DeletePriceList")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Delete(&models.PriceList{}, "id = ?", priceListID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPriceListNotFound
+	}
+	return nil
+}
+
+func (r *postgresPricingRepository) GetPriceList(ctx context.Context, priceListID string) (models.PriceList, error) {
+	ctx, span := r.tracer.Start(ctx, "GetPriceList")
+	defer span.End()
+
+	var list models.PriceList
+	err := r.db.WithContext(ctx).Where("id = ?", priceListID).First(&list).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return list, ErrPriceListNotFound
+	}
+	return list, err
+}
+
+// FindPriceList returns the oldest price list in a currency, which is the one used when a
+// caller asks for a currency rather than a list.
+func (r *postgresPricingRepository) FindPriceList(ctx context.Context, currency string) (models.PriceList, error) {
+	ctx, span := r.tracer.Start(ctx, "FindPriceList")
+	defer span.End()
+
+	var list models.PriceList
+	err := r.db.WithContext(ctx).Where("currency = ?", currency).Order("created_at").Order("id").First(&list).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return list, ErrPriceListNotFound
+	}
+	return list, err
+}
+
+func (r *postgresPricingRepository) ListPriceLists(ctx context.Context) ([]models.PriceList, error) {
+	ctx, span := r.tracer.Start(ctx, "ListPriceLists")
+	defer span.End()
+
+	var lists []models.PriceList
+	err := r.db.WithContext(ctx).Order("currency").Order("id").Find(&lists).Error
+	return lists, err
+}
+
+// SetPrice creates or replaces a product's explicit price in a price list.
+func (r *postgresPricingRepository) SetPrice(ctx context.Context, entry models.PriceListEntry) (models.PriceListEntry, error) {
+	ctx, span := r.tracer.Start(ctx, "SetPrice")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var lists int64
+		if err := tx.Model(&models.PriceList{}).Where("id = ?", entry.PriceListID).Count(&lists).Error; err != nil {
+			return err
+		}
+		if lists == 0 {
+			return ErrPriceListNotFound
+		}
+		var products int64
+		if err := tx.Model(&models.ProductStock{}).Where("product_id = ? AND archived_at IS NULL", entry.ProductID).Count(&products).Error; err != nil {
+			return err
+		}
+		if products == 0 {
+			return ErrProductNotFound
+		}
+		return tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "price_list_id"}, {Name: "product_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"minor_units", "updated_at"}),
+		}).Create(&entry).Error
+	})
+	return entry, err
+}
+
+func (r *postgresPricingRepository) DeletePrice(ctx context.Context, priceListID string, productID string) error {
+	ctx, span := r.tracer.Start(ctx, "DeletePrice")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Delete(&models.PriceListEntry{}, "price_list_id = ? AND product_id = ?", priceListID, productID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPriceListEntryNotFound
+	}
+	return nil
+}
+
+// GetPrices returns the explicit prices a price list has for the given products, keyed by
+// product ID. Products without an entry are left out.
+func (r *postgresPricingRepository) GetPrices(ctx context.Context, priceListID string, productIDs []string) (map[string]int64, error) {
+	ctx, span := r.tracer.Start(ctx, "GetPrices")
+	defer span.End()
+
+	prices := make(map[string]int64)
+	if len(productIDs) == 0 {
+		return prices, nil
+	}
+	var entries []models.PriceListEntry
+	if err := r.db.WithContext(ctx).Where("price_list_id = ? AND product_id IN ?", priceListID, productIDs).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		prices[entry.ProductID] = entry.MinorUnits
+	}
+	return prices, nil
+}
+
+// SetExchangeRate creates or replaces the rate of a currency pair.
+func (r *postgresPricingRepository) SetExchangeRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error) {
+	ctx, span := r.tracer.Start(ctx, "SetExchangeRate")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&rate).Error
+	return rate, err
+}
+
+func (r *postgresPricingRepository) DeleteExchangeRate(ctx context.Context, baseCurrency string, quoteCurrency string) error {
+	ctx, span := r.tracer.Start(ctx, "DeleteExchangeRate")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Delete(&models.ExchangeRate{}, "base_currency = ? AND quote_currency = ?", baseCurrency, quoteCurrency)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrExchangeRateNotFound
+	}
+	return nil
+}
+
+func (r *postgresPricingRepository) ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	ctx, span := r.tracer.Start(ctx, "ListExchangeRates")
+	defer span.End()
+
+	var rates []models.ExchangeRate
+	err := r.db.WithContext(ctx).Order("base_currency").Order("quote_currency").Find(&rates).Error
+	return rates, err
+}
+
+// GetExchangeRates returns every rate that converts into or out of currency.
+func (r *postgresPricingRepository) GetExchangeRates(ctx context.Context, currency string) ([]models.ExchangeRate, error) {
+	ctx, span := r.tracer.Start(ctx, "GetExchangeRates")
+	defer span.End()
+
+	var rates []models.ExchangeRate
+	err := r.db.WithContext(ctx).Where("base_currency = ? OR quote_currency = ?", currency, currency).Find(&rates).Error
+	return rates, err
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidSort      = errors.New("invalid sort field")
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+var productSortColumns = map[models.ProductSort]string{
+	models.SortByProductID: "product_id",
+	models.SortByName:      "name",
+	models.SortByPrice:     "price_minor_units",
+	models.SortByQuantity:  availableQuantitySQL,
+	models.SortByUpdatedAt: "updated_at",
+}
+
+// productCursor is the keyset position after the last product of a page: its sort value
+// and product ID. The sort order is carried along so a token cannot be replayed against a
+// differently ordered listing.
+type productCursor struct {
+	SortBy     models.ProductSort `json:"s,omitempty"`
+	Descending bool               `json:"d,omitempty"`
+	Value      json.RawMessage    `json:"v,omitempty"`
+	ProductID  string             `json:"id"`
+}
+
+// applyProductFilters narrows a product query to the requested price range, stock state and
+// category subtree. Archived products are never listed, and grouped listings only return
+// top-level products.
+func applyProductFilters(tx *gorm.DB, query models.ProductQuery) *gorm.DB {
+	tx = tx.Where("archived_at IS NULL")
+	if query.MinPrice > 0 {
+		tx = tx.Where("price_minor_units >= ?", query.MinPrice)
+	}
+	if query.MaxPrice > 0 {
+		tx = tx.Where("price_minor_units <= ?", query.MaxPrice)
+	}
+	// A bundle is in stock when its components are
+	inStock := "(quantity > 0 OR " + bundleInStockSQL + ")"
+	if query.GroupVariants {
+		// A grouped parent is in stock when any of its variants is
+		inStock = `(quantity > 0 OR ` + bundleInStockSQL + ` OR EXISTS (
+			SELECT 1 FROM product_stocks AS v
+			WHERE v.parent_id = product_stocks.product_id AND v.archived_at IS NULL AND v.quantity > 0))`
+		tx = tx.Where("parent_id IS NULL")
+	}
+	if query.InStockOnly {
+		tx = tx.Where(inStock)
+	}
+	if query.OutOfStockOnly {
+		tx = tx.Where("NOT " + inStock)
+	}
+	if query.CategoryID != "" {
+		tx = tx.Where(`product_id IN (
+			SELECT pc.product_id FROM product_categories AS pc
+			WHERE pc.category_id IN (`+categorySubtreeSQL+`))`, query.CategoryID)
+	}
+	return tx
+}
+
+// applyProductPage orders a product query and, given a page token, resumes after its cursor.
+func applyProductPage(tx *gorm.DB, query models.ProductQuery) (*gorm.DB, error) {
+	column, ok := productSortColumns[query.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSort, query.SortBy)
+	}
+	direction, compare := "ASC", ">"
+	if query.Descending {
+		direction, compare = "DESC", "<"
+	}
+
+	if query.PageToken != "" {
+		cursor, err := decodeProductCursor(query)
+		if err != nil {
+			return nil, err
+		}
+		if query.SortBy == models.SortByProductID {
+			tx = tx.Where("product_id "+compare+" ?", cursor.ProductID)
+		} else {
+			value, err := cursorValue(query.SortBy, cursor.Value)
+			if err != nil {
+				return nil, err
+			}
+			tx = tx.Where("("+column+", product_id) "+compare+" (?, ?)", value, cursor.ProductID)
+		}
+	}
+
+	if query.SortBy != models.SortByProductID {
+		tx = tx.Order(column + " " + direction)
+	}
+	return tx.Order("product_id " + direction), nil
+}
+
+// encodeProductCursor builds the page token pointing just past product.
+func encodeProductCursor(query models.ProductQuery, product models.ProductStock) (string, error) {
+	cursor := productCursor{SortBy: query.SortBy, Descending: query.Descending, ProductID: product.ProductID}
+
+	var value interface{}
+	switch query.SortBy {
+	case models.SortByName:
+		value = product.Name
+	case models.SortByPrice:
+		value = product.UnitPrice.MinorUnits
+	case models.SortByQuantity:
+		value = product.Quantity
+	case models.SortByUpdatedAt:
+		value = product.UpdatedAt
+	}
+	if value != nil {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		cursor.Value = raw
+	}
+
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeProductCursor(query models.ProductQuery) (productCursor, error) {
+	var cursor productCursor
+	raw, err := base64.RawURLEncoding.DecodeString(query.PageToken)
+	if err != nil {
+		return cursor, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return cursor, ErrInvalidPageToken
+	}
+	if cursor.SortBy != query.SortBy || cursor.Descending != query.Descending {
+		return cursor, fmt.Errorf("%w: token was issued for a different sort order", ErrInvalidPageToken)
+	}
+	return cursor, nil
+}
+
+// cursorValue decodes the cursor's sort value into the Go type of its column, so it is
+// bound as a typed parameter rather than compared as text.
+func cursorValue(sortBy models.ProductSort, raw json.RawMessage) (interface{}, error) {
+	var value interface{}
+	var err error
+	switch sortBy {
+	case models.SortByName:
+		var name string
+		err = json.Unmarshal(raw, &name)
+		value = name
+	case models.SortByPrice:
+		var price int64
+		err = json.Unmarshal(raw, &price)
+		value = price
+	case models.SortByQuantity:
+		var quantity int32
+		err = json.Unmarshal(raw, &quantity)
+		value = quantity
+	case models.SortByUpdatedAt:
+		var updatedAt time.Time
+		err = json.Unmarshal(raw, &updatedAt)
+		value = updatedAt
+	}
+	if err != nil || value == nil {
+		return nil, ErrInvalidPageToken
+	}
+	return value, nil
+}
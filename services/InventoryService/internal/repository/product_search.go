@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrEmptySearchQuery = errors.New("search query is required")
+
+// searchWordSimilarity is the pg_trgm word similarity a product name needs to match a term
+// that full-text search missed. It is low enough to forgive a transposed or dropped letter
+// ("laptpo", "keybord") without matching unrelated words.
+const searchWordSimilarity = 0.4
+
+// searchCursor resumes a search at an offset. Relevance ranks are not unique or stable
+// enough for keyset pagination, so search pages by position instead. The query is carried
+// along so a token cannot be used with a different search.
+type searchCursor struct {
+	Query  string `json:"q"`
+	Offset int    `json:"o"`
+}
+
+// SearchProducts ranks products by full-text relevance, with name matches weighted above
+// description matches, plus trigram similarity of the name so misspelled terms still find
+// their products. query.PageSize must be positive.
+func (r *postgresRepository) SearchProducts(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	ctx, span := r.tracer.Start(ctx, "SearchProducts")
+	defer span.End()
+
+	term := strings.TrimSpace(query.Query)
+	if term == "" {
+		return models.SearchPage{}, ErrEmptySearchQuery
+	}
+	offset, err := decodeSearchCursor(query.PageToken, term)
+	if err != nil {
+		return models.SearchPage{}, err
+	}
+
+	var hits []models.SearchHit
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Scoped to this transaction so the <% operator below can still use the trigram index
+		if err := tx.Exec("SELECT set_config('pg_trgm.word_similarity_threshold', ?, true)", fmt.Sprint(searchWordSimilarity)).Error; err != nil {
+			return err
+		}
+		// Fetch one extra row to learn whether another page follows
+		return tx.Raw(`SELECT p.*, ts_rank(p.search_vector, q.tsq) + word_similarity(q.term, p.name) AS rank
+			FROM product_stocks AS p,
+				(SELECT websearch_to_tsquery('english', @term) AS tsq, CAST(@term AS text) AS term) AS q
+			WHERE p.archived_at IS NULL AND (p.search_vector @@ q.tsq OR q.term <% p.name)
+			ORDER BY rank DESC, p.product_id
+			LIMIT @limit OFFSET @offset`,
+			map[string]interface{}{"term": term, "limit": query.PageSize + 1, "offset": offset}).
+			Scan(&hits).Error
+	})
+	if err != nil {
+		return models.SearchPage{}, err
+	}
+
+	products := make([]*models.ProductStock, len(hits))
+	for i := range hits {
+		products[i] = &hits[i].ProductStock
+	}
+	if err := hydrateProducts(r.db.WithContext(ctx), time.Now(), products...); err != nil {
+		return models.SearchPage{}, err
+	}
+
+	page := models.SearchPage{Hits: hits}
+	if len(hits) > query.PageSize {
+		page.Hits = hits[:query.PageSize]
+		token, err := encodeSearchCursor(searchCursor{Query: term, Offset: offset + query.PageSize})
+		if err != nil {
+			return models.SearchPage{}, err
+		}
+		page.NextPageToken = token
+	}
+	return page, nil
+}
+
+func encodeSearchCursor(cursor searchCursor) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeSearchCursor(token string, term string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	var cursor searchCursor
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.Offset < 0 {
+		return 0, ErrInvalidPageToken
+	}
+	if cursor.Query != term {
+		return 0, fmt.Errorf("%w: token was issued for a different search", ErrInvalidPageToken)
+	}
+	return cursor.Offset, nil
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPromotionNotFound = errors.New("promotion not found")
+	ErrPromotionExists   = errors.New("promotion already exists")
+)
+
+// ruleColumns are what numeric conditions test. Quantity is the stock listings report, so a
+// bundle is judged by what its components make up rather than its empty stock column.
+var ruleColumns = map[models.RuleAttribute]string{
+	models.RulePrice:    "price_minor_units",
+	models.RuleQuantity: availableQuantitySQL,
+}
+
+var ruleOperators = map[models.RuleOperator]string{
+	models.OpLessThan:       "<",
+	models.OpLessOrEqual:    "<=",
+	models.OpGreaterThan:    ">",
+	models.OpGreaterOrEqual: ">=",
+	models.OpEqual:          "=",
+}
+
+type PromotionRepository interface {
+	CreatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error)
+	UpdatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error)
+	DeletePromotion(ctx context.Context, promotionID string) error
+	GetPromotion(ctx context.Context, promotionID string) (models.Promotion, error)
+	ListPromotions(ctx context.Context) ([]models.Promotion, error)
+	ListOffers(ctx context.Context, at time.Time) ([]models.Offer, error)
+}
+
+type postgresPromotionRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+func NewPostgresPromotionRepository(db *gorm.DB) PromotionRepository {
+	return &postgresPromotionRepository{
+		db:     db,
+		tracer: otel.Tracer("PromotionRepository"),
+	}
+}
+
+func (r *postgresPromotionRepository) CreatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error) {
+	ctx, span := r.tracer.Start(ctx, "CreatePromotion")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&promotion)
+	if result.Error != nil {
+		return promotion, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return promotion, ErrPromotionExists
+	}
+	return promotion, nil
+}
+
+func (r *postgresPromotionRepository) UpdatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error) {
+	ctx, span := r.tracer.Start(ctx, "UpdatePromotion")
+	defer span.End()
+
+	var updated models.Promotion
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("id = ?", promotion.ID).First(&updated).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPromotionNotFound
+			}
+			return err
+		}
+		promotion.CreatedAt = updated.CreatedAt
+		updated = promotion
+		return tx.Save(&updated).Error
+	})
+	return updated, err
+}
+
+func (r *postgresPromotionRepository) DeletePromotion(ctx context.Context, promotionID string) error {
+	ctx, span := r.tracer.Start(ctx, "DeletePromotion")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Delete(&models.Promotion{}, "id = ?", promotionID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPromotionNotFound
+	}
+	return nil
+}
+
+func (r *postgresPromotionRepository) GetPromotion(ctx context.Context, promotionID string) (models.Promotion, error) {
+	ctx, span := r.tracer.Start(ctx, "GetPromotion")
+	defer span.End()
+
+	var promotion models.Promotion
+	err := r.db.WithContext(ctx).Where("id = ?", promotionID).First(&promotion).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return promotion, ErrPromotionNotFound
+	}
+	return promotion, err
+}
+
+// ListPromotions returns every promotion, expired and future ones included, in the order
+// they take precedence.
+func (r *postgresPromotionRepository) ListPromotions(ctx context.Context) ([]models.Promotion, error) {
+	ctx, span := r.tracer.Start(ctx, "ListPromotions")
+	defer span.End()
+
+	var promotions []models.Promotion
+	err := r.db.WithContext(ctx).Order("priority DESC").Order("id").Find(&promotions).Error
+	return promotions, err
+}
+
+// offerRow is a product with the ID of the promotion that applies to it.
+type offerRow struct {
+	models.ProductStock `gorm:"embedded"`
+	PromotionID         string
+}
+
+// ListOffers evaluates every promotion active at the given time in a single query and
+// returns the sellable products they select, each with the highest-priority promotion that
+// selects it. Parents with variants are left out; their variants are listed instead. Price
+// conditions test the catalog price, while discounts apply to any scheduled price running at.
+func (r *postgresPromotionRepository) ListOffers(ctx context.Context, at time.Time) ([]models.Offer, error) {
+	ctx, span := r.tracer.Start(ctx, "ListOffers")
+	defer span.End()
+
+	var promotions []models.Promotion
+	err := r.db.WithContext(ctx).
+		Where("(starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)", at, at).
+		Order("priority DESC").Order("id").
+		Find(&promotions).Error
+	if err != nil || len(promotions) == 0 {
+		return nil, err
+	}
+
+	// The first matching branch of the CASE is the promotion with the highest priority
+	var cases []string
+	var args []interface{}
+	byID := make(map[string]models.Promotion, len(promotions))
+	for _, promotion := range promotions {
+		condition, conditionArgs, err := compileRule(promotion)
+		if err != nil {
+			return nil, fmt.Errorf("promotion %s: %w", promotion.ID, err)
+		}
+		cases = append(cases, "WHEN "+condition+" THEN ?")
+		args = append(append(args, conditionArgs...), promotion.ID)
+		byID[promotion.ID] = promotion
+	}
+
+	var rows []offerRow
+	err = r.db.WithContext(ctx).Raw(`SELECT * FROM (
+			SELECT product_stocks.*, CASE `+strings.Join(cases, " ")+` END AS promotion_id
+			FROM product_stocks
+			WHERE archived_at IS NULL AND NOT EXISTS (
+				SELECT 1 FROM product_stocks AS v
+				WHERE v.parent_id = product_stocks.product_id AND v.archived_at IS NULL)
+		) AS offers
+		WHERE promotion_id IS NOT NULL
+		ORDER BY product_id`, args...).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*models.ProductStock, len(rows))
+	for i := range rows {
+		products[i] = &rows[i].ProductStock
+	}
+	if err := hydrateProducts(r.db.WithContext(ctx), at, products...); err != nil {
+		return nil, err
+	}
+
+	offers := make([]models.Offer, 0, len(rows))
+	for _, row := range rows {
+		promotion := byID[row.PromotionID]
+		offers = append(offers, models.Offer{
+			Product:         row.ProductStock,
+			OriginalPrice:   row.UnitPrice,
+			DiscountedPrice: promotion.Apply(row.UnitPrice),
+			Promotion:       promotion,
+		})
+	}
+	return offers, nil
+}
+
+// compileRule turns a promotion's rule into a SQL condition over product_stocks. A fixed
+// discount also requires the product to be priced in the discount's currency.
+func compileRule(promotion models.Promotion) (string, []interface{}, error) {
+	var conditions []string
+	var args []interface{}
+	for _, condition := range promotion.Rule.Conditions {
+		sql, conditionArgs, err := compileCondition(condition)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, sql)
+		args = append(args, conditionArgs...)
+	}
+
+	rule := "TRUE"
+	if len(conditions) > 0 {
+		join := " AND "
+		if promotion.Rule.Match == models.MatchAny {
+			join = " OR "
+		}
+		rule = "(" + strings.Join(conditions, join) + ")"
+	}
+	if promotion.DiscountType == models.DiscountFixed {
+		rule = "(" + rule + " AND price_currency = ?)"
+		args = append(args, promotion.DiscountCurrency)
+	}
+	return rule, args, nil
+}
+
+func compileCondition(condition models.PromotionCondition) (string, []interface{}, error) {
+	if !condition.Valid() {
+		return "", nil, fmt.Errorf("unsupported rule condition %s %s", condition.Attribute, condition.Operator)
+	}
+	switch condition.Attribute {
+	case models.RuleProductID:
+		return "(product_id IN ? OR parent_id IN ?)", []interface{}{condition.Values, condition.Values}, nil
+	case models.RuleCategoryID:
+		var subtrees []string
+		var args []interface{}
+		for _, categoryID := range condition.Values {
+			subtrees = append(subtrees, "pc.category_id IN ("+categorySubtreeSQL+")")
+			args = append(args, categoryID)
+		}
+		return `EXISTS (
+			SELECT 1 FROM product_categories AS pc
+			WHERE pc.product_id IN (product_stocks.product_id, product_stocks.parent_id)
+			AND (` + strings.Join(subtrees, " OR ") + `))`, args, nil
+	default:
+		return ruleColumns[condition.Attribute] + " " + ruleOperators[condition.Operator] + " ?", []interface{}{condition.Value}, nil
+	}
+}
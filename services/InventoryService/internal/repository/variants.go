@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrNestedVariant    = errors.New("a variant cannot have variants of its own")
+	ErrDuplicateVariant = errors.New("a variant with these options already exists")
+	ErrNotAVariant      = errors.New("product is not a variant")
+	ErrParentHasStock   = errors.New("parent product still holds stock; move it to its variants first")
+)
+
+// CreateVariant adds a variant SKU under an existing top-level product. Its price is the
+// override, in minor units of the parent's currency, when one is given and the parent's
+// price otherwise.
+func (r *postgresRepository) CreateVariant(ctx context.Context, variant models.ProductStock) (models.ProductStock, error) {
+	ctx, span := r.tracer.Start(ctx, "CreateVariant")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return insertVariant(tx, &variant)
+	})
+	return variant, err
+}
+
+// insertVariant creates a variant under its parent, filling in the parent's name and price.
+func insertVariant(tx *gorm.DB, variant *models.ProductStock) error {
+	// Lock the parent so its price cannot change while the variant copies it
+	var parent models.ProductStock
+	if err := forUpdate(tx).Where("product_id = ?", *variant.ParentID).First(&parent).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+	if parent.ParentID != nil {
+		return ErrNestedVariant
+	}
+	if parent.ArchivedAt != nil {
+		return fmt.Errorf("parent %w", ErrProductArchived)
+	}
+	if parent.Quantity > 0 || parent.Reserved > 0 {
+		// Once it has variants the parent's own stock could never be reserved again
+		return ErrParentHasStock
+	}
+	bundles, err := loadBundleComponents(tx, []string{parent.ProductID})
+	if err != nil {
+		return err
+	}
+	if bundles[parent.ProductID] != nil {
+		return fmt.Errorf("parent %w", ErrProductIsBundle)
+	}
+	if err := checkUniqueOptions(tx, parent.ProductID, variant.ProductID, variant.Options); err != nil {
+		return err
+	}
+
+	if variant.Name == "" {
+		variant.Name = parent.Name
+	}
+	variant.SetUnitPrice(parent.UnitPrice)
+	if variant.UnitPriceOverride != nil {
+		variant.SetUnitPrice(models.Money{MinorUnits: *variant.UnitPriceOverride, Currency: parent.UnitPrice.Currency})
+	}
+	variant.SetUnitPriceOverride(variant.UnitPriceOverride)
+	return tx.Omit("Reserved", clause.Associations).Create(variant).Error
+}
+
+// UpdateVariant changes a variant's option values and price override, given in minor units
+// of the parent's currency. A nil override makes the variant follow its parent's price again.
+func (r *postgresRepository) UpdateVariant(ctx context.Context, productID string, options models.OptionValues, priceOverride *int64) (models.ProductStock, error) {
+	ctx, span := r.tracer.Start(ctx, "UpdateVariant")
+	defer span.End()
+
+	var variant models.ProductStock
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Where("product_id = ?", productID).First(&variant).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+		if variant.ParentID == nil {
+			return ErrNotAVariant
+		}
+		if err := checkUniqueOptions(tx, *variant.ParentID, productID, options); err != nil {
+			return err
+		}
+
+		var parent models.ProductStock
+		if err := tx.Select("price_minor_units", "price_currency").Where("product_id = ?", *variant.ParentID).First(&parent).Error; err != nil {
+			return err
+		}
+		price := parent.UnitPrice
+		if priceOverride != nil {
+			price.MinorUnits = *priceOverride
+		}
+
+		variant.Options = options
+		variant.SetUnitPrice(price)
+		variant.SetUnitPriceOverride(priceOverride)
+		variant.Version++
+		return tx.Model(&variant).
+			Select("options", "price_minor_units", "price_currency", "price", "price_override_minor_units", "price_override", "version").
+			Updates(&variant).Error
+	})
+	return variant, err
+}
+
+func (r *postgresRepository) ListVariants(ctx context.Context, parentID string) ([]models.ProductStock, error) {
+	ctx, span := r.tracer.Start(ctx, "ListVariants")
+	defer span.End()
+
+	var variants []models.ProductStock
+	if err := r.db.WithContext(ctx).Where("parent_id = ? AND archived_at IS NULL", parentID).Order("product_id").Find(&variants).Error; err != nil {
+		return nil, err
+	}
+	return variants, hydrateProducts(r.db.WithContext(ctx), time.Now(), productPointers(variants)...)
+}
+
+// checkUniqueOptions refuses option values already used by another variant of the parent.
+func checkUniqueOptions(tx *gorm.DB, parentID string, productID string, options models.OptionValues) error {
+	var duplicates int64
+	if err := tx.Model(&models.ProductStock{}).
+		Where("parent_id = ? AND product_id <> ? AND options = ?", parentID, productID, options).
+		Count(&duplicates).Error; err != nil {
+		return err
+	}
+	if duplicates > 0 {
+		return ErrDuplicateVariant
+	}
+	return nil
+}
+
+// parentsWithVariants reports which of the given products have variants and therefore hold
+// no reservable stock of their own.
+func parentsWithVariants(tx *gorm.DB, productIDs []string) (map[string]bool, error) {
+	var parentIDs []string
+	if err := tx.Model(&models.ProductStock{}).
+		Distinct("parent_id").
+		Where("parent_id IN ?", productIDs).
+		Pluck("parent_id", &parentIDs).Error; err != nil {
+		return nil, err
+	}
+	parents := make(map[string]bool, len(parentIDs))
+	for _, parentID := range parentIDs {
+		parents[parentID] = true
+	}
+	return parents, nil
+}
+
+// checkCurrencyChange refuses a price in another currency than a variant's parent, and a
+// parent currency change that would strand variant price overrides in the old currency.
+func checkCurrencyChange(tx *gorm.DB, product models.ProductStock, currency string) error {
+	if currency == product.UnitPrice.Currency {
+		return nil
+	}
+	if product.ParentID != nil {
+		return ErrCurrencyMismatch
+	}
+	var overrides int64
+	if err := tx.Model(&models.ProductStock{}).
+		Where("parent_id = ? AND price_override_minor_units IS NOT NULL", product.ProductID).
+		Count(&overrides).Error; err != nil {
+		return err
+	}
+	if overrides > 0 {
+		return fmt.Errorf("%w: clear the variant price overrides before changing currency", ErrCurrencyMismatch)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidAttributeDefinition = errors.New("attribute definition needs a snake_case key, a name, a known type, and distinct enum values for ENUM only")
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+func (s *categoryService) CreateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error) {
+	definition, err := newAttributeDefinition(definition)
+	if err != nil {
+		return models.AttributeDefinition{}, err
+	}
+	slog.InfoContext(ctx, "Creating attribute definition", "key", definition.Key, "type", definition.Type, "category_id", categoryOf(definition))
+	return s.repo.CreateAttributeDefinition(ctx, definition)
+}
+
+func (s *categoryService) UpdateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error) {
+	definition, err := newAttributeDefinition(definition)
+	if err != nil {
+		return models.AttributeDefinition{}, err
+	}
+	slog.InfoContext(ctx, "Updating attribute definition", "key", definition.Key, "type", definition.Type, "category_id", categoryOf(definition))
+	return s.repo.UpdateAttributeDefinition(ctx, definition)
+}
+
+// DeleteAttributeDefinition removes the definition and the attribute's values from every product.
+func (s *categoryService) DeleteAttributeDefinition(ctx context.Context, key string) error {
+	slog.InfoContext(ctx, "Deleting attribute definition", "key", key)
+	return s.repo.DeleteAttributeDefinition(ctx, key)
+}
+
+// ListAttributeDefinitions lists every definition, or the ones that apply to a category's
+// products when categoryID is set.
+func (s *categoryService) ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error) {
+	return s.repo.ListAttributeDefinitions(ctx, categoryID)
+}
+
+func newAttributeDefinition(definition models.AttributeDefinition) (models.AttributeDefinition, error) {
+	definition.Name = strings.TrimSpace(definition.Name)
+	definition.Unit = strings.TrimSpace(definition.Unit)
+	if !attributeKeyPattern.MatchString(definition.Key) || definition.Name == "" {
+		return definition, ErrInvalidAttributeDefinition
+	}
+	if definition.CategoryID != nil && *definition.CategoryID == "" {
+		definition.CategoryID = nil
+	}
+
+	switch definition.Type {
+	case models.AttributeString, models.AttributeNumber, models.AttributeBoolean:
+		if len(definition.EnumValues) > 0 {
+			return definition, ErrInvalidAttributeDefinition
+		}
+	case models.AttributeEnum:
+		if len(definition.EnumValues) == 0 {
+			return definition, ErrInvalidAttributeDefinition
+		}
+		seen := make(map[string]bool, len(definition.EnumValues))
+		for _, value := range definition.EnumValues {
+			if value == "" || seen[value] {
+				return definition, ErrInvalidAttributeDefinition
+			}
+			seen[value] = true
+		}
+	default:
+		return definition, ErrInvalidAttributeDefinition
+	}
+	return definition, nil
+}
+
+// categoryOf is the definition's category for logging; empty for catalog-wide definitions.
+func categoryOf(definition models.AttributeDefinition) string {
+	if definition.CategoryID == nil {
+		return ""
+	}
+	return *definition.CategoryID
+}
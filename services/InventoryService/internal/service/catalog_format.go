@@ -0,0 +1,335 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// catalogColumns are the CSV columns of a catalog file, in the order exports write them.
+// Options and attributes are JSON objects and components a JSON array; categories are IDs
+// separated by categorySeparator.
+var catalogColumns = []string{
+	"product_id", "parent_id", "name", "description", "currency", "price", "quantity", "options", "categories", "attributes", "components",
+}
+
+const categorySeparator = "|"
+
+// catalogRow is one record read from an import file, or why it could not be read.
+type catalogRow struct {
+	Row    int
+	Record models.CatalogRecord
+	Err    error
+}
+
+// decodeCatalog reads every record of an import file. Records that cannot be read are
+// returned with their error; the error result means the file as a whole is unreadable.
+func decodeCatalog(format models.CatalogFormat, data []byte) ([]catalogRow, error) {
+	switch format {
+	case models.CatalogCSV:
+		return decodeCatalogCSV(data)
+	case models.CatalogJSON:
+		return decodeCatalogJSON(data)
+	}
+	return nil, ErrInvalidCatalogFormat
+}
+
+// decodeCatalogCSV reads a CSV file with a header row naming its columns, in any order. Only
+// product_id is required; a missing column keeps the current value as a nil record field
+// does, while an empty cell clears the categories, attributes or components.
+func decodeCatalogCSV(data []byte) ([]catalogRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(catalogColumns, name) {
+			return nil, fmt.Errorf("unknown column %q; columns are %s", name, strings.Join(catalogColumns, ", "))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("column %q appears twice", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["product_id"]; !ok {
+		return nil, errors.New("the product_id column is required")
+	}
+
+	var rows []catalogRow
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		row := catalogRow{Row: len(rows) + 1}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) || !errors.Is(parseErr.Err, csv.ErrFieldCount) {
+				return nil, err
+			}
+			row.Err = fmt.Errorf("line %d has %d fields, the header has %d", parseErr.Line, len(fields), len(header))
+		} else {
+			row.Record, row.Err = parseCatalogFields(columns, fields)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func parseCatalogFields(columns map[string]int, fields []string) (models.CatalogRecord, error) {
+	field := func(name string) (string, bool) {
+		i, ok := columns[name]
+		if !ok {
+			return "", false
+		}
+		return fields[i], true
+	}
+
+	var record models.CatalogRecord
+	record.ProductID, _ = field("product_id")
+	if parentID, _ := field("parent_id"); parentID != "" {
+		record.ParentID = &parentID
+	}
+	record.Name, _ = field("name")
+	if description, ok := field("description"); ok {
+		record.Description = &description
+	}
+
+	currency, _ := field("currency")
+	if price, _ := field("price"); price != "" {
+		minorUnits, err := parseAmount(price, currency)
+		if err != nil {
+			return record, err
+		}
+		record.UnitPrice = &models.Money{MinorUnits: minorUnits, Currency: currency}
+	}
+	if quantity, _ := field("quantity"); quantity != "" {
+		n, err := strconv.ParseInt(quantity, 10, 32)
+		if err != nil {
+			return record, fmt.Errorf("quantity %q is not a whole number", quantity)
+		}
+		record.Quantity = int32(n)
+	}
+	if options, _ := field("options"); options != "" {
+		if err := json.Unmarshal([]byte(options), &record.Options); err != nil {
+			return record, errors.New(`options must be a JSON object of strings, e.g. {"size":"M"}`)
+		}
+	}
+	if categories, ok := field("categories"); ok {
+		record.Categories = []string{}
+		for _, categoryID := range strings.Split(categories, categorySeparator) {
+			if categoryID = strings.TrimSpace(categoryID); categoryID != "" {
+				record.Categories = append(record.Categories, categoryID)
+			}
+		}
+	}
+	if attributes, ok := field("attributes"); ok {
+		record.Attributes = models.AttributeValues{}
+		if attributes != "" {
+			if err := json.Unmarshal([]byte(attributes), &record.Attributes); err != nil || record.Attributes == nil {
+				return record, errors.New(`attributes must be a JSON object, e.g. {"size_in":27}`)
+			}
+		}
+	}
+	if components, ok := field("components"); ok {
+		record.Components = []models.BundleComponent{}
+		if components != "" {
+			if err := json.Unmarshal([]byte(components), &record.Components); err != nil || record.Components == nil {
+				return record, errors.New(`components must be a JSON array, e.g. [{"productId":"PROD-001","quantity":2}]`)
+			}
+		}
+	}
+	return record, nil
+}
+
+// decodeCatalogJSON reads a JSON array of catalog records. A record with unknown or mistyped
+// fields is a row error; broken JSON makes the whole file unreadable.
+func decodeCatalogJSON(data []byte) ([]catalogRow, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if token != json.Delim('[') {
+		return nil, errors.New("a JSON catalog must be an array of products")
+	}
+
+	var rows []catalogRow
+	for decoder.More() {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, err
+		}
+		row := catalogRow{Row: len(rows) + 1}
+		recordDecoder := json.NewDecoder(bytes.NewReader(raw))
+		recordDecoder.DisallowUnknownFields()
+		row.Err = recordDecoder.Decode(&row.Record)
+		if row.Record.ParentID != nil && *row.Record.ParentID == "" {
+			row.Record.ParentID = nil
+		}
+		rows = append(rows, row)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// catalogWriter writes catalog records to an export file.
+type catalogWriter interface {
+	Write(record models.CatalogRecord) error
+	Close() error
+}
+
+func newCatalogWriter(format models.CatalogFormat, w io.Writer) (catalogWriter, error) {
+	switch format {
+	case models.CatalogCSV:
+		return &csvCatalogWriter{writer: csv.NewWriter(w)}, nil
+	case models.CatalogJSON:
+		return &jsonCatalogWriter{writer: w}, nil
+	}
+	return nil, ErrInvalidCatalogFormat
+}
+
+type csvCatalogWriter struct {
+	writer      *csv.Writer
+	wroteHeader bool
+}
+
+func (w *csvCatalogWriter) Write(record models.CatalogRecord) error {
+	if !w.wroteHeader {
+		if err := w.writer.Write(catalogColumns); err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+
+	var parentID, description, currency, price, options, attributes, components string
+	if record.ParentID != nil {
+		parentID = *record.ParentID
+	}
+	if record.Description != nil {
+		description = *record.Description
+	}
+	if record.UnitPrice != nil {
+		currency = record.UnitPrice.Currency
+		price = formatAmount(record.UnitPrice.MinorUnits, currency)
+	}
+	if len(record.Options) > 0 {
+		raw, err := json.Marshal(record.Options)
+		if err != nil {
+			return err
+		}
+		options = string(raw)
+	}
+	if len(record.Attributes) > 0 {
+		raw, err := json.Marshal(record.Attributes)
+		if err != nil {
+			return err
+		}
+		attributes = string(raw)
+	}
+	if len(record.Components) > 0 {
+		raw, err := json.Marshal(record.Components)
+		if err != nil {
+			return err
+		}
+		components = string(raw)
+	}
+	return w.writer.Write([]string{
+		record.ProductID, parentID, record.Name, description, currency, price,
+		strconv.FormatInt(int64(record.Quantity), 10), options, strings.Join(record.Categories, categorySeparator), attributes, components,
+	})
+}
+
+func (w *csvCatalogWriter) Close() error {
+	if !w.wroteHeader {
+		if err := w.writer.Write(catalogColumns); err != nil {
+			return err
+		}
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// jsonCatalogWriter writes a JSON array with one record per line.
+type jsonCatalogWriter struct {
+	writer io.Writer
+	count  int
+}
+
+func (w *jsonCatalogWriter) Write(record models.CatalogRecord) error {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	separator := ",\n"
+	if w.count == 0 {
+		separator = "[\n"
+	}
+	w.count++
+	_, err = w.writer.Write(append([]byte(separator), raw...))
+	return err
+}
+
+func (w *jsonCatalogWriter) Close() error {
+	closing := "\n]\n"
+	if w.count == 0 {
+		closing = "[]\n"
+	}
+	_, err := io.WriteString(w.writer, closing)
+	return err
+}
+
+// parseAmount reads a decimal amount such as "12.99" into minor units of the currency,
+// refusing more decimals than the currency has.
+func parseAmount(amount string, currency string) (int64, error) {
+	if currency == "" {
+		return 0, errors.New("a price needs a currency")
+	}
+	whole, fraction, _ := strings.Cut(amount, ".")
+	exponent := models.CurrencyExponent(currency)
+	if whole == "" || !isDigits(whole) || !isDigits(fraction) || len(fraction) > exponent {
+		return 0, fmt.Errorf("price %q must be a non-negative amount with at most %d decimals for %s", amount, exponent, currency)
+	}
+	minorUnits, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", exponent-len(fraction)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("price %q is out of range", amount)
+	}
+	return minorUnits, nil
+}
+
+// formatAmount writes minor units of the currency as a decimal amount, e.g. 1299 USD as "12.99".
+func formatAmount(minorUnits int64, currency string) string {
+	exponent := models.CurrencyExponent(currency)
+	digits := fmt.Sprintf("%0*d", exponent+1, minorUnits)
+	if exponent == 0 {
+		return digits
+	}
+	return digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
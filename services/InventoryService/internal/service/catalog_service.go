@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"io"
+	"log/slog"
+	"time"
+)
+
+const (
+	MaxImportBytes      = 32 << 20
+	ImportBatchSize     = 500  // Records committed per transaction by a real import or an upsert stream
+	MaxReportedFailures = 1000 // Failed rows reported per import job or upsert stream; the failed count includes them all
+
+	// importStaleAfter is how long a running job may go without progress before another
+	// instance takes it over.
+	importStaleAfter = 10 * time.Minute
+	exportPageSize   = 500
+)
+
+var (
+	ErrInvalidCatalogFormat = errors.New("catalog format must be csv or json")
+	ErrImportTooLarge       = errors.New("catalog file must be at most 32 MB")
+	ErrInvalidCatalogRecord = errors.New("invalid catalog record")
+)
+
+type CatalogService interface {
+	StartImport(ctx context.Context, format models.CatalogFormat, dryRun bool, data []byte) (models.ImportJob, error)
+	GetImportJob(ctx context.Context, jobID int64) (models.ImportJob, error)
+	RunNextImport(ctx context.Context) (bool, error)
+	ExportCatalog(ctx context.Context, format models.CatalogFormat, w io.Writer) error
+	UpsertProducts(ctx context.Context, records []models.CatalogRecord, dryRun bool) ([]models.UpsertResult, error)
+}
+
+type catalogService struct {
+	repo repository.CatalogRepository
+}
+
+func NewCatalogService(repo repository.CatalogRepository) CatalogService {
+	return &catalogService{repo: repo}
+}
+
+// StartImport queues a catalog file to be imported in the background; RunNextImport picks it up.
+func (s *catalogService) StartImport(ctx context.Context, format models.CatalogFormat, dryRun bool, data []byte) (models.ImportJob, error) {
+	if format != models.CatalogCSV && format != models.CatalogJSON {
+		return models.ImportJob{}, ErrInvalidCatalogFormat
+	}
+	if len(data) > MaxImportBytes {
+		return models.ImportJob{}, ErrImportTooLarge
+	}
+	slog.InfoContext(ctx, "Queueing catalog import", "format", format, "dry_run", dryRun, "bytes", len(data))
+	return s.repo.CreateImportJob(ctx, models.ImportJob{
+		Format:  format,
+		DryRun:  dryRun,
+		Status:  models.ImportPending,
+		Payload: data,
+	})
+}
+
+func (s *catalogService) GetImportJob(ctx context.Context, jobID int64) (models.ImportJob, error) {
+	return s.repo.GetImportJob(ctx, jobID)
+}
+
+// RunNextImport runs the next queued import to completion and reports whether there was one.
+// Rows that fail are recorded on the job; an error means the job's outcome could not be saved.
+func (s *catalogService) RunNextImport(ctx context.Context) (bool, error) {
+	job, err := s.repo.ClaimImportJob(ctx, time.Now().Add(-importStaleAfter))
+	if errors.Is(err, repository.ErrImportJobNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	slog.InfoContext(ctx, "Running catalog import", "job_id", job.ID, "format", job.Format, "dry_run", job.DryRun)
+	if err := s.runImport(ctx, &job); err != nil {
+		slog.ErrorContext(ctx, "Catalog import failed", "job_id", job.ID, "error", err)
+		job.Status = models.ImportFailed
+		job.Message = err.Error()
+	} else {
+		job.Status = models.ImportSucceeded
+	}
+	now := time.Now()
+	job.FinishedAt = &now
+	if err := s.repo.SaveImportProgress(ctx, job); err != nil {
+		return true, err
+	}
+	slog.InfoContext(ctx, "Catalog import finished", "job_id", job.ID, "status", job.Status,
+		"created", job.Created, "updated", job.Updated, "failed", job.Failed)
+	return true, nil
+}
+
+// runImport upserts the job's rows. A real import commits every ImportBatchSize rows and
+// saves its progress in between; a dry run tries every row in one transaction it rolls back,
+// so rows can still refer to parents created earlier in the file.
+func (s *catalogService) runImport(ctx context.Context, job *models.ImportJob) error {
+	rows, err := decodeCatalog(job.Format, job.Payload)
+	if err != nil {
+		return fmt.Errorf("cannot read the %s file: %w", job.Format, err)
+	}
+	job.Total = len(rows)
+
+	batchSize := ImportBatchSize
+	if job.DryRun {
+		batchSize = max(len(rows), 1)
+	}
+	for start := 0; start < len(rows); start += batchSize {
+		batch := rows[start:min(start+batchSize, len(rows))]
+		if err := s.importBatch(ctx, job, batch); err != nil {
+			return err
+		}
+		if job.Processed < job.Total {
+			if err := s.repo.SaveImportProgress(ctx, *job); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// importBatch upserts the rows that could be read and records every failure on the job in
+// row order.
+func (s *catalogService) importBatch(ctx context.Context, job *models.ImportJob, rows []catalogRow) error {
+	failures := make([]string, len(rows))
+	var records []models.CatalogRecord
+	var positions []int
+	for i, row := range rows {
+		if row.Err != nil {
+			failures[i] = row.Err.Error()
+			continue
+		}
+		records = append(records, row.Record)
+		positions = append(positions, i)
+	}
+
+	results, err := s.UpsertProducts(ctx, records, job.DryRun)
+	if err != nil {
+		return err
+	}
+	for i, result := range results {
+		switch result.Outcome {
+		case models.UpsertCreated:
+			job.Created++
+		case models.UpsertUpdated:
+			job.Updated++
+		default:
+			failures[positions[i]] = result.Message
+		}
+	}
+
+	for i, failure := range failures {
+		if failure == "" {
+			continue
+		}
+		job.Failed++
+		if len(job.Errors) < MaxReportedFailures {
+			job.Errors = append(job.Errors, models.ImportRowError{Row: rows[i].Row, ProductID: rows[i].Record.ProductID, Message: failure})
+		}
+	}
+	job.Processed += len(rows)
+	return nil
+}
+
+// UpsertProducts creates or replaces one chunk of products in one transaction and returns
+// an outcome for each record, in order. Invalid records fail without reaching the database;
+// the error result means the chunk could not be applied at all.
+func (s *catalogService) UpsertProducts(ctx context.Context, records []models.CatalogRecord, dryRun bool) ([]models.UpsertResult, error) {
+	results := make([]models.UpsertResult, len(records))
+	var valid []models.CatalogRecord
+	var positions []int
+	for i, record := range records {
+		if err := checkCatalogRecord(record); err != nil {
+			results[i] = models.UpsertResult{ProductID: record.ProductID, Outcome: models.UpsertFailed, Message: err.Error()}
+			continue
+		}
+		valid = append(valid, record)
+		positions = append(positions, i)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	upserted, err := s.repo.UpsertProducts(ctx, valid, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range upserted {
+		results[positions[i]] = result
+	}
+	return results, nil
+}
+
+// ExportCatalog writes every product that is not archived to w, parents before variants and
+// bundles after the products they are made of, in a form StartImport reads back.
+func (s *catalogService) ExportCatalog(ctx context.Context, format models.CatalogFormat, w io.Writer) error {
+	writer, err := newCatalogWriter(format, w)
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "Exporting catalog", "format", format)
+
+	var after *models.CatalogRecord
+	for {
+		records, err := s.repo.ExportProducts(ctx, after, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		if len(records) < exportPageSize {
+			return writer.Close()
+		}
+		after = &records[len(records)-1]
+	}
+}
+
+// checkCatalogRecord checks what can be checked without the database. A top-level product
+// needs a name and a price; a variant needs option values, and its price, if any, is an
+// override in its parent's currency.
+func checkCatalogRecord(record models.CatalogRecord) error {
+	if record.ProductID == "" {
+		return fmt.Errorf("%w: product id is required", ErrInvalidCatalogRecord)
+	}
+	if record.Quantity < 0 {
+		return fmt.Errorf("%w: quantity must not be negative", ErrInvalidCatalogRecord)
+	}
+	if err := checkBundleComponents(record.ProductID, record.Components); err != nil {
+		return err
+	}
+	if record.ParentID != nil {
+		if len(record.Options) == 0 {
+			return ErrInvalidVariant
+		}
+		if record.UnitPrice != nil && (record.UnitPrice.MinorUnits < 0 || record.UnitPrice.Currency != "" && !models.ValidCurrency(record.UnitPrice.Currency)) {
+			return ErrInvalidPrice
+		}
+		return nil
+	}
+	if record.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidCatalogRecord)
+	}
+	if len(record.Options) > 0 {
+		return fmt.Errorf("%w: only variants have options", ErrInvalidCatalogRecord)
+	}
+	if record.UnitPrice == nil || !record.UnitPrice.Valid() {
+		return ErrInvalidPrice
+	}
+	return nil
+}
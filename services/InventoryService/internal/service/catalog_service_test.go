@@ -0,0 +1,271 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCatalogRepository is a mock of the CatalogRepository interface
+type MockCatalogRepository struct {
+	mock.Mock
+}
+
+func (m *MockCatalogRepository) UpsertProducts(ctx context.Context, records []models.CatalogRecord, dryRun bool) ([]models.UpsertResult, error) {
+	args := m.Called(ctx, records, dryRun)
+	return args.Get(0).([]models.UpsertResult), args.Error(1)
+}
+
+func (m *MockCatalogRepository) ExportProducts(ctx context.Context, after *models.CatalogRecord, limit int) ([]models.CatalogRecord, error) {
+	args := m.Called(ctx, after, limit)
+	return args.Get(0).([]models.CatalogRecord), args.Error(1)
+}
+
+func (m *MockCatalogRepository) CreateImportJob(ctx context.Context, job models.ImportJob) (models.ImportJob, error) {
+	args := m.Called(ctx, job)
+	return args.Get(0).(models.ImportJob), args.Error(1)
+}
+
+func (m *MockCatalogRepository) GetImportJob(ctx context.Context, jobID int64) (models.ImportJob, error) {
+	args := m.Called(ctx, jobID)
+	return args.Get(0).(models.ImportJob), args.Error(1)
+}
+
+func (m *MockCatalogRepository) ClaimImportJob(ctx context.Context, staleBefore time.Time) (models.ImportJob, error) {
+	args := m.Called(ctx, staleBefore)
+	return args.Get(0).(models.ImportJob), args.Error(1)
+}
+
+func (m *MockCatalogRepository) SaveImportProgress(ctx context.Context, job models.ImportJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+const catalogCSV = "product_id,name,currency,price,quantity,categories,attributes\n" +
+	"PROD-100,Desk Lamp,USD,24.50,10,lighting|office,\"{\"\"wattage\"\":9}\"\n" +
+	"PROD-101,,USD,5,1,,\n" +
+	"PROD-102,Monitor Arm,USD,abc,1,,\n" +
+	"PROD-001,High-Performance Laptop,USD,1299.99,0,,\n"
+
+func TestCatalogService_RunNextImport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Upserts Valid Rows And Reports The Rest", func(t *testing.T) {
+		mockRepo := new(MockCatalogRepository)
+		svc := NewCatalogService(mockRepo)
+		job := models.ImportJob{ID: 7, Format: models.CatalogCSV, Status: models.ImportRunning, Payload: []byte(catalogCSV)}
+		mockRepo.On("ClaimImportJob", ctx, mock.Anything).Return(job, nil).Once()
+		mockRepo.On("UpsertProducts", ctx, mock.MatchedBy(func(records []models.CatalogRecord) bool {
+			return len(records) == 2 &&
+				records[0].ProductID == "PROD-100" && *records[0].UnitPrice == models.Money{MinorUnits: 2450, Currency: "USD"} &&
+				assert.ObjectsAreEqual([]string{"lighting", "office"}, records[0].Categories) &&
+				records[0].Attributes["wattage"] == float64(9) &&
+				records[1].ProductID == "PROD-001"
+		}), false).Return([]models.UpsertResult{
+			{ProductID: "PROD-100", Outcome: models.UpsertFailed, Message: "category not found"},
+			{ProductID: "PROD-001", Outcome: models.UpsertUpdated},
+		}, nil).Once()
+		mockRepo.On("SaveImportProgress", ctx, mock.MatchedBy(func(saved models.ImportJob) bool {
+			return saved.Status == models.ImportSucceeded && saved.FinishedAt != nil &&
+				saved.Total == 4 && saved.Processed == 4 && saved.Updated == 1 && saved.Failed == 3 &&
+				len(saved.Errors) == 3 &&
+				saved.Errors[0].Row == 1 && saved.Errors[0].Message == "category not found" &&
+				saved.Errors[1].Row == 2 && saved.Errors[1].ProductID == "PROD-101" &&
+				saved.Errors[2].Row == 3
+		})).Return(nil).Once()
+
+		ran, err := svc.RunNextImport(ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, ran)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Dry Run Checks Every Row In One Call", func(t *testing.T) {
+		mockRepo := new(MockCatalogRepository)
+		svc := NewCatalogService(mockRepo)
+		var payload bytes.Buffer
+		payload.WriteString("[")
+		for i := 0; i < ImportBatchSize+1; i++ {
+			if i > 0 {
+				payload.WriteString(",")
+			}
+			payload.WriteString(`{"productId":"P","name":"N","unitPrice":{"minorUnits":100,"currency":"EUR"}}`)
+		}
+		payload.WriteString("]")
+		job := models.ImportJob{ID: 8, Format: models.CatalogJSON, DryRun: true, Status: models.ImportRunning, Payload: payload.Bytes()}
+		results := make([]models.UpsertResult, ImportBatchSize+1)
+		for i := range results {
+			results[i].Outcome = models.UpsertCreated
+		}
+		mockRepo.On("ClaimImportJob", ctx, mock.Anything).Return(job, nil).Once()
+		mockRepo.On("UpsertProducts", ctx, mock.MatchedBy(func(records []models.CatalogRecord) bool {
+			return len(records) == ImportBatchSize+1
+		}), true).Return(results, nil).Once()
+		mockRepo.On("SaveImportProgress", ctx, mock.MatchedBy(func(saved models.ImportJob) bool {
+			return saved.Status == models.ImportSucceeded && saved.Created == ImportBatchSize+1
+		})).Return(nil).Once()
+
+		ran, err := svc.RunNextImport(ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, ran)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Fails Job With Unreadable File", func(t *testing.T) {
+		mockRepo := new(MockCatalogRepository)
+		svc := NewCatalogService(mockRepo)
+		job := models.ImportJob{ID: 9, Format: models.CatalogCSV, Status: models.ImportRunning, Payload: []byte("sku,name\nPROD-1,Lamp\n")}
+		mockRepo.On("ClaimImportJob", ctx, mock.Anything).Return(job, nil).Once()
+		mockRepo.On("SaveImportProgress", ctx, mock.MatchedBy(func(saved models.ImportJob) bool {
+			return saved.Status == models.ImportFailed && saved.Message != ""
+		})).Return(nil).Once()
+
+		ran, err := svc.RunNextImport(ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, ran)
+		mockRepo.AssertNotCalled(t, "UpsertProducts", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Reports An Empty Queue", func(t *testing.T) {
+		mockRepo := new(MockCatalogRepository)
+		svc := NewCatalogService(mockRepo)
+		mockRepo.On("ClaimImportJob", ctx, mock.Anything).Return(models.ImportJob{}, repository.ErrImportJobNotFound).Once()
+
+		ran, err := svc.RunNextImport(ctx)
+
+		assert.NoError(t, err)
+		assert.False(t, ran)
+	})
+}
+
+func TestCatalogService_StartImport(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockCatalogRepository)
+	svc := NewCatalogService(mockRepo)
+
+	_, err := svc.StartImport(ctx, "xlsx", false, []byte("x"))
+
+	assert.True(t, errors.Is(err, ErrInvalidCatalogFormat))
+	mockRepo.AssertNotCalled(t, "CreateImportJob", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_UpsertProducts(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockCatalogRepository)
+	svc := NewCatalogService(mockRepo)
+	records := []models.CatalogRecord{
+		{ProductID: "PROD-300", Name: "Desk Lamp", UnitPrice: &models.Money{MinorUnits: 2450, Currency: "USD"}},
+		{ProductID: "PROD-301", UnitPrice: &models.Money{MinorUnits: 100, Currency: "USD"}},
+		{ProductID: "PROD-302", Name: "Monitor Arm", UnitPrice: &models.Money{MinorUnits: 4999, Currency: "USD"}},
+	}
+	mockRepo.On("UpsertProducts", ctx, []models.CatalogRecord{records[0], records[2]}, false).Return([]models.UpsertResult{
+		{ProductID: "PROD-300", Outcome: models.UpsertCreated},
+		{ProductID: "PROD-302", Outcome: models.UpsertUpdated},
+	}, nil).Once()
+
+	results, err := svc.UpsertProducts(ctx, records, false)
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, models.UpsertCreated, results[0].Outcome)
+		assert.Equal(t, models.UpsertFailed, results[1].Outcome)
+		assert.Equal(t, "PROD-301", results[1].ProductID)
+		assert.Equal(t, models.UpsertUpdated, results[2].Outcome)
+	}
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_ExportCatalog(t *testing.T) {
+	ctx := context.Background()
+	parentID := "PROD-200"
+	description := "Adjustable, with a USB port"
+	records := []models.CatalogRecord{
+		{
+			ProductID: "PROD-200", Name: "Desk Lamp", Description: &description,
+			UnitPrice: &models.Money{MinorUnits: 2450, Currency: "USD"}, Quantity: 0,
+			Categories: []string{"lighting", "office"}, Attributes: models.AttributeValues{"wattage": float64(9)},
+			Components: []models.BundleComponent{},
+		},
+		{
+			ProductID: "PROD-200-BLK", ParentID: &parentID, Name: "Desk Lamp", Description: &description,
+			UnitPrice: &models.Money{MinorUnits: 2600, Currency: "USD"}, Quantity: 4,
+			Options: models.OptionValues{"colour": "Black"}, Categories: []string{}, Attributes: models.AttributeValues{},
+			Components: []models.BundleComponent{},
+		},
+		{
+			ProductID: "DESK-SETUP", Name: "Desk Setup", Description: &description,
+			UnitPrice: &models.Money{MinorUnits: 9900, Currency: "USD"}, Categories: []string{}, Attributes: models.AttributeValues{},
+			Components: []models.BundleComponent{{ComponentID: "PROD-200-BLK", Quantity: 1}, {ComponentID: "PROD-300", Quantity: 2}},
+		},
+	}
+
+	for _, format := range []models.CatalogFormat{models.CatalogCSV, models.CatalogJSON} {
+		t.Run("Round Trips "+string(format), func(t *testing.T) {
+			mockRepo := new(MockCatalogRepository)
+			svc := NewCatalogService(mockRepo)
+			mockRepo.On("ExportProducts", ctx, (*models.CatalogRecord)(nil), exportPageSize).Return(records, nil).Once()
+
+			var file bytes.Buffer
+			err := svc.ExportCatalog(ctx, format, &file)
+			assert.NoError(t, err)
+
+			rows, err := decodeCatalog(format, file.Bytes())
+			assert.NoError(t, err)
+			if assert.Len(t, rows, len(records)) {
+				for i, row := range rows {
+					assert.NoError(t, row.Err)
+					assert.Equal(t, records[i], row.Record)
+				}
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	cases := []struct {
+		amount   string
+		currency string
+		want     int64
+		valid    bool
+	}{
+		{"12.99", "USD", 1299, true},
+		{"12.9", "USD", 1290, true},
+		{"12", "USD", 1200, true},
+		{"1500", "JPY", 1500, true},
+		{"1.005", "KWD", 1005, true},
+		{"12.999", "USD", 0, false},
+		{"1.5", "JPY", 0, false},
+		{"-1", "USD", 0, false},
+		{".5", "USD", 0, false},
+		{"12.99", "", 0, false},
+	}
+	for _, c := range cases {
+		got, err := parseAmount(c.amount, c.currency)
+		if c.valid {
+			assert.NoError(t, err, c.amount)
+			assert.Equal(t, c.want, got, c.amount)
+		} else {
+			assert.Error(t, err, c.amount)
+		}
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "12.99", formatAmount(1299, "USD"))
+	assert.Equal(t, "0.05", formatAmount(5, "USD"))
+	assert.Equal(t, "1500", formatAmount(1500, "JPY"))
+	assert.Equal(t, "1.005", formatAmount(1005, "KWD"))
+}
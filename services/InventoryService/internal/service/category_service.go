@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"log/slog"
+	"strings"
+)
+
+var ErrInvalidCategory = errors.New("category id and name are required")
+
+type CategoryService interface {
+	CreateCategory(ctx context.Context, categoryID string, name string, parentID string) (models.Category, error)
+	UpdateCategory(ctx context.Context, categoryID string, name string, parentID string) (models.Category, error)
+	DeleteCategory(ctx context.Context, categoryID string) error
+	GetCategory(ctx context.Context, categoryID string) (models.Category, error)
+	ListCategories(ctx context.Context) ([]models.Category, error)
+	SetProductCategories(ctx context.Context, productID string, categoryIDs []string) ([]models.Category, error)
+	GetProductCategories(ctx context.Context, productID string) ([]models.Category, error)
+	CreateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error)
+	UpdateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error)
+	DeleteAttributeDefinition(ctx context.Context, key string) error
+	ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error)
+}
+
+type categoryService struct {
+	repo repository.CategoryRepository
+}
+
+func NewCategoryService(repo repository.CategoryRepository) CategoryService {
+	return &categoryService{repo: repo}
+}
+
+// CreateCategory adds a category; an empty parentID creates a root category.
+func (s *categoryService) CreateCategory(ctx context.Context, categoryID string, name string, parentID string) (models.Category, error) {
+	category, err := newCategory(categoryID, name, parentID)
+	if err != nil {
+		return models.Category{}, err
+	}
+	slog.InfoContext(ctx, "Creating category", "category_id", category.ID, "parent_id", parentID)
+	return s.repo.CreateCategory(ctx, category)
+}
+
+// UpdateCategory renames a category and sets its parent; an empty parentID makes it a root.
+func (s *categoryService) UpdateCategory(ctx context.Context, categoryID string, name string, parentID string) (models.Category, error) {
+	category, err := newCategory(categoryID, name, parentID)
+	if err != nil {
+		return models.Category{}, err
+	}
+	slog.InfoContext(ctx, "Updating category", "category_id", category.ID, "parent_id", parentID)
+	return s.repo.UpdateCategory(ctx, category)
+}
+
+func (s *categoryService) DeleteCategory(ctx context.Context, categoryID string) error {
+	slog.InfoContext(ctx, "Deleting category", "category_id", categoryID)
+	return s.repo.DeleteCategory(ctx, categoryID)
+}
+
+func (s *categoryService) GetCategory(ctx context.Context, categoryID string) (models.Category, error) {
+	return s.repo.GetCategory(ctx, categoryID)
+}
+
+func (s *categoryService) ListCategories(ctx context.Context) ([]models.Category, error) {
+	return s.repo.ListCategories(ctx)
+}
+
+// SetProductCategories replaces a product's categories and returns the new assignment.
+func (s *categoryService) SetProductCategories(ctx context.Context, productID string, categoryIDs []string) ([]models.Category, error) {
+	slog.InfoContext(ctx, "Assigning product categories", "product_id", productID, "category_ids", categoryIDs)
+	if err := s.repo.SetProductCategories(ctx, productID, categoryIDs); err != nil {
+		return nil, err
+	}
+	return s.repo.GetProductCategories(ctx, productID)
+}
+
+func (s *categoryService) GetProductCategories(ctx context.Context, productID string) ([]models.Category, error) {
+	return s.repo.GetProductCategories(ctx, productID)
+}
+
+func newCategory(categoryID string, name string, parentID string) (models.Category, error) {
+	category := models.Category{ID: strings.TrimSpace(categoryID), Name: strings.TrimSpace(name)}
+	if category.ID == "" || category.Name == "" {
+		return category, ErrInvalidCategory
+	}
+	if parentID != "" {
+		category.ParentID = &parentID
+	}
+	return category, nil
+}
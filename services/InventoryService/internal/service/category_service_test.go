@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCategoryRepository is a mock of the CategoryRepository interface
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) CreateCategory(ctx context.Context, category models.Category) (models.Category, error) {
+	args := m.Called(ctx, category)
+	return args.Get(0).(models.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) UpdateCategory(ctx context.Context, category models.Category) (models.Category, error) {
+	args := m.Called(ctx, category)
+	return args.Get(0).(models.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) DeleteCategory(ctx context.Context, categoryID string) error {
+	args := m.Called(ctx, categoryID)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) GetCategory(ctx context.Context, categoryID string) (models.Category, error) {
+	args := m.Called(ctx, categoryID)
+	return args.Get(0).(models.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ListCategories(ctx context.Context) ([]models.Category, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error {
+	args := m.Called(ctx, productID, categoryIDs)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) GetProductCategories(ctx context.Context, productID string) ([]models.Category, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).([]models.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) CreateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error) {
+	args := m.Called(ctx, definition)
+	return args.Get(0).(models.AttributeDefinition), args.Error(1)
+}
+
+func (m *MockCategoryRepository) UpdateAttributeDefinition(ctx context.Context, definition models.AttributeDefinition) (models.AttributeDefinition, error) {
+	args := m.Called(ctx, definition)
+	return args.Get(0).(models.AttributeDefinition), args.Error(1)
+}
+
+func (m *MockCategoryRepository) DeleteAttributeDefinition(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error) {
+	args := m.Called(ctx, categoryID)
+	return args.Get(0).([]models.AttributeDefinition), args.Error(1)
+}
+
+func TestCategoryService_CreateCategory(t *testing.T) {
+	mockRepo := new(MockCategoryRepository)
+	svc := NewCategoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Child Category", func(t *testing.T) {
+		parent := "peripherals"
+		category := models.Category{ID: "keyboards", Name: "Keyboards", ParentID: &parent}
+		mockRepo.On("CreateCategory", ctx, category).Return(category, nil).Once()
+
+		created, err := svc.CreateCategory(ctx, " keyboards ", "Keyboards", "peripherals")
+
+		assert.NoError(t, err)
+		assert.Equal(t, category, created)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Root Category", func(t *testing.T) {
+		category := models.Category{ID: "peripherals", Name: "Peripherals"}
+		mockRepo.On("CreateCategory", ctx, category).Return(category, nil).Once()
+
+		created, err := svc.CreateCategory(ctx, "peripherals", "Peripherals", "")
+
+		assert.NoError(t, err)
+		assert.Nil(t, created.ParentID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Missing Name", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepository)
+		svc := NewCategoryService(mockRepo)
+
+		_, err := svc.CreateCategory(ctx, "keyboards", " ", "")
+
+		assert.ErrorIs(t, err, ErrInvalidCategory)
+		mockRepo.AssertNotCalled(t, "CreateCategory", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCategoryService_UpdateCategory(t *testing.T) {
+	mockRepo := new(MockCategoryRepository)
+	svc := NewCategoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Cycle Is Rejected", func(t *testing.T) {
+		parent := "mechanical"
+		mockRepo.On("UpdateCategory", ctx, models.Category{ID: "keyboards", Name: "Keyboards", ParentID: &parent}).Return(models.Category{}, repository.ErrCategoryCycle).Once()
+
+		_, err := svc.UpdateCategory(ctx, "keyboards", "Keyboards", "mechanical")
+
+		assert.ErrorIs(t, err, repository.ErrCategoryCycle)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCategoryService_SetProductCategories(t *testing.T) {
+	mockRepo := new(MockCategoryRepository)
+	svc := NewCategoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Returns New Assignment", func(t *testing.T) {
+		assigned := []models.Category{{ID: "keyboards", Name: "Keyboards"}}
+		mockRepo.On("SetProductCategories", ctx, "PROD-003", []string{"keyboards"}).Return(nil).Once()
+		mockRepo.On("GetProductCategories", ctx, "PROD-003").Return(assigned, nil).Once()
+
+		categories, err := svc.SetProductCategories(ctx, "PROD-003", []string{"keyboards"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, assigned, categories)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Product", func(t *testing.T) {
+		mockRepo.On("SetProductCategories", ctx, "PROD-404", []string{"keyboards"}).Return(repository.ErrProductNotFound).Once()
+
+		_, err := svc.SetProductCategories(ctx, "PROD-404", []string{"keyboards"})
+
+		assert.ErrorIs(t, err, repository.ErrProductNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCategoryService_CreateAttributeDefinition(t *testing.T) {
+	mockRepo := new(MockCategoryRepository)
+	svc := NewCategoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Enum Attribute", func(t *testing.T) {
+		category := "keyboards"
+		definition := models.AttributeDefinition{
+			Key: "connectivity", Name: "Connectivity", Type: models.AttributeEnum,
+			EnumValues: models.StringList{"usb", "bluetooth"}, CategoryID: &category,
+		}
+		mockRepo.On("CreateAttributeDefinition", ctx, definition).Return(definition, nil).Once()
+
+		created, err := svc.CreateAttributeDefinition(ctx, definition)
+
+		assert.NoError(t, err)
+		assert.Equal(t, definition, created)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Definitions", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepository)
+		svc := NewCategoryService(mockRepo)
+
+		for _, definition := range []models.AttributeDefinition{
+			{Key: "Screen Size", Name: "Screen size", Type: models.AttributeNumber},
+			{Key: "size_in", Name: " ", Type: models.AttributeNumber},
+			{Key: "size_in", Name: "Screen size", Type: "DATE"},
+			{Key: "size_in", Name: "Screen size", Type: models.AttributeNumber, EnumValues: models.StringList{"27"}},
+			{Key: "connectivity", Name: "Connectivity", Type: models.AttributeEnum},
+			{Key: "connectivity", Name: "Connectivity", Type: models.AttributeEnum, EnumValues: models.StringList{"usb", "usb"}},
+		} {
+			_, err := svc.CreateAttributeDefinition(ctx, definition)
+
+			assert.ErrorIs(t, err, ErrInvalidAttributeDefinition, definition.Key)
+		}
+		mockRepo.AssertNotCalled(t, "CreateAttributeDefinition", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAttributeDefinition_ParseValue(t *testing.T) {
+	size := models.AttributeDefinition{Key: "size_in", Type: models.AttributeNumber}
+	wireless := models.AttributeDefinition{Key: "wireless", Type: models.AttributeBoolean}
+	connectivity := models.AttributeDefinition{Key: "connectivity", Type: models.AttributeEnum, EnumValues: models.StringList{"usb", "bluetooth"}}
+
+	value, err := size.ParseValue("27.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 27.5, value)
+
+	value, err = wireless.ParseValue("true")
+	assert.NoError(t, err)
+	assert.Equal(t, true, value)
+
+	_, err = size.ParseValue("large")
+	assert.Error(t, err)
+	_, err = connectivity.ParseValue("serial")
+	assert.Error(t, err)
+	assert.Error(t, connectivity.Check(1.0))
+	assert.NoError(t, connectivity.Check("bluetooth"))
+}
@@ -2,52 +2,159 @@ package service
 
 import (
 	"context"
+	"errors"
 	"inventory-service/internal/models"
 	"inventory-service/internal/repository"
+	"inventory-service/internal/storage"
 	"log/slog"
+	"time"
 )
 
+// DefaultReservationTTL is how long a reservation is held when neither the caller nor the
+// service configuration asks for something else. Only pending reservations expire: once an
+// order is confirmed its stock is never returned by the sweeper.
+const DefaultReservationTTL = 15 * time.Minute
+
+// DefaultPageSize and MaxPageSize bound product listings.
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+// DefaultRetention is how long settled reservations are kept before they may be purged.
+const DefaultRetention = 30 * 24 * time.Hour
+
+// ErrInvalidVariant is returned when a variant has no SKU or no option values to tell it apart.
+var ErrInvalidVariant = errors.New("variant product id and option values are required")
+
+// ErrVersionRequired is returned for a product update that does not say which version it edits.
+var ErrVersionRequired = errors.New("expected product version is required")
+
+// ErrInvalidPrice is returned for a negative price or one without an ISO 4217 currency code.
+var ErrInvalidPrice = errors.New("price must not be negative and needs an ISO 4217 currency code")
+
+// ErrInvalidAdjustment is returned for a stock adjustment that would change nothing.
+var ErrInvalidAdjustment = errors.New("stock adjustment delta must not be zero")
+
+// ErrInvalidBundle is returned for bundle components without a SKU or a positive quantity,
+// listed twice, or naming the bundle itself.
+var ErrInvalidBundle = errors.New("each bundle component needs its own product id, other than the bundle's, and a positive quantity")
+
 type InventoryService interface {
-	Reserve(ctx context.Context, orderID string, productID string, quantity int32) (bool, string, error)
+	Reserve(ctx context.Context, orderID string, productID string, quantity int32, ttl time.Duration) (bool, string, error)
 	Release(ctx context.Context, orderID string, productID string, quantity int32) (bool, string, error)
-	BatchReserve(ctx context.Context, orderID string, items []models.BatchItem) (bool, string, error)
-	BatchRelease(ctx context.Context, orderID string, items []models.BatchItem) (bool, string, error)
+	BatchReserve(ctx context.Context, orderID string, items []models.BatchItem, ttl time.Duration, allowPartial bool) (bool, string, []models.BatchItemResult, error)
+	BatchRelease(ctx context.Context, orderID string, items []models.BatchItem) (bool, string, []models.BatchItemResult, error)
+	ConfirmReservation(ctx context.Context, orderID string) (bool, string, error)
+	CancelReservation(ctx context.Context, orderID string) (bool, string, error)
+	GetReservation(ctx context.Context, orderID string) (models.ReservationSummary, error)
+	ListExpiredReservations(ctx context.Context, limit int) ([]string, error)
+	ExpireReservation(ctx context.Context, orderID string) ([]models.BatchItemResult, error)
+	PurgeCompletedReservations(ctx context.Context, limit int) (int64, int64, error)
 	GetStock(ctx context.Context, productID string) (int32, error)
 	GetProduct(ctx context.Context, productID string) (models.ProductStock, error)
-	ListProducts(ctx context.Context) ([]models.ProductStock, error)
-	GetOffers(ctx context.Context) ([]models.ProductStock, error)
-	CreateProduct(ctx context.Context, productID string, name string, price float64, quantity int32) (bool, string, error)
-	UpdateProduct(ctx context.Context, productID string, name string, price float64, quantity int32) (bool, string, error)
+	ListProducts(ctx context.Context, query models.ProductQuery) (models.ProductPage, error)
+	SearchProducts(ctx context.Context, query models.SearchQuery) (models.SearchPage, error)
+	CreateProduct(ctx context.Context, productID string, name string, description string, price models.Money, quantity int32) (bool, string, error)
+	UpdateProduct(ctx context.Context, productID string, version int64, anyVersion bool, name string, description string, price models.Money) (bool, string, error)
+	PatchProduct(ctx context.Context, productID string, patch models.ProductPatch) (models.ProductStock, error)
+	AdjustStock(ctx context.Context, productID string, delta int32, reason string) (models.ProductStock, error)
 	DeleteProduct(ctx context.Context, productID string) (bool, string, error)
+	RestoreProduct(ctx context.Context, productID string) (models.ProductStock, error)
+	PurgeProduct(ctx context.Context, productID string) error
+	CreateVariant(ctx context.Context, parentID string, productID string, name string, options models.OptionValues, priceOverride *int64, quantity int32) (models.ProductStock, error)
+	UpdateVariant(ctx context.Context, productID string, options models.OptionValues, priceOverride *int64) (models.ProductStock, error)
+	ListVariants(ctx context.Context, parentID string) ([]models.ProductStock, error)
 	RestockItems(ctx context.Context, productID string, quantity int32) (bool, string, error)
+	SetBundleComponents(ctx context.Context, bundleID string, components []models.BundleComponent) (models.ProductStock, error)
 }
 
 type inventoryService struct {
-	repo repository.InventoryRepository
+	repo           repository.InventoryRepository
+	reservationTTL time.Duration
+	retention      time.Duration
+	images         storage.Storage
 }
 
-func NewInventoryService(repo repository.InventoryRepository) InventoryService {
-	return &inventoryService{repo: repo}
+// Option customises an InventoryService at construction time.
+type Option func(*inventoryService)
+
+// WithReservationTTL sets the lifetime of reservations whose caller did not ask for one.
+func WithReservationTTL(ttl time.Duration) Option {
+	return func(s *inventoryService) {
+		if ttl > 0 {
+			s.reservationTTL = ttl
+		}
+	}
 }
 
-func (s *inventoryService) ListProducts(ctx context.Context) ([]models.ProductStock, error) {
-	slog.InfoContext(ctx, "Listing all products")
-	return s.repo.ListProducts(ctx)
+// WithRetention sets how long settled reservations and their idempotency records are kept.
+// It bounds how late a client may retry an order and still be recognised as a replay.
+func WithRetention(retention time.Duration) Option {
+	return func(s *inventoryService) {
+		if retention > 0 {
+			s.retention = retention
+		}
+	}
 }
 
-func (s *inventoryService) GetOffers(ctx context.Context) ([]models.ProductStock, error) {
-	slog.InfoContext(ctx, "Listing product offers")
-	return s.repo.GetOffers(ctx)
+// WithImageStorage sets where product images are stored, so purging a product also deletes
+// its image files.
+func WithImageStorage(images storage.Storage) Option {
+	return func(s *inventoryService) {
+		s.images = images
+	}
+}
+
+func NewInventoryService(repo repository.InventoryRepository, opts ...Option) InventoryService {
+	s := &inventoryService{repo: repo, reservationTTL: DefaultReservationTTL, retention: DefaultRetention}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// expiresAt resolves the expiry of a reservation made now with the requested ttl.
+func (s *inventoryService) expiresAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		ttl = s.reservationTTL
+	}
+	return time.Now().Add(ttl)
+}
+
+// ListProducts returns one page of products, clamping the page size to [1, MaxPageSize].
+func (s *inventoryService) ListProducts(ctx context.Context, query models.ProductQuery) (models.ProductPage, error) {
+	slog.InfoContext(ctx, "Listing products", "page_size", query.PageSize, "sort_by", query.SortBy, "descending", query.Descending)
+	if query.PageSize <= 0 {
+		query.PageSize = DefaultPageSize
+	}
+	query.PageSize = min(query.PageSize, MaxPageSize)
+	return s.repo.ListProducts(ctx, query)
+}
+
+// SearchProducts returns one page of ranked search results, clamping the page size like
+// ListProducts.
+func (s *inventoryService) SearchProducts(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	slog.InfoContext(ctx, "Searching products", "query", query.Query, "page_size", query.PageSize)
+	if query.PageSize <= 0 {
+		query.PageSize = DefaultPageSize
+	}
+	query.PageSize = min(query.PageSize, MaxPageSize)
+	return s.repo.SearchProducts(ctx, query)
 }
 
-func (s *inventoryService) CreateProduct(ctx context.Context, productID string, name string, price float64, quantity int32) (bool, string, error) {
+func (s *inventoryService) CreateProduct(ctx context.Context, productID string, name string, description string, price models.Money, quantity int32) (bool, string, error) {
 	slog.InfoContext(ctx, "Creating product", "product_id", productID, "name", name)
+	if !price.Valid() {
+		return false, ErrInvalidPrice.Error(), nil
+	}
 	product := models.ProductStock{
-		ProductID: productID,
-		Name:      name,
-		Price:     price,
-		Quantity:  quantity,
+		ProductID:   productID,
+		Name:        name,
+		Description: description,
+		Quantity:    quantity,
 	}
+	product.SetUnitPrice(price)
 	err := s.repo.CreateProduct(ctx, product)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to create product", "error", err)
@@ -56,15 +163,16 @@ func (s *inventoryService) CreateProduct(ctx context.Context, productID string,
 	return true, "Product created successfully", nil
 }
 
-func (s *inventoryService) UpdateProduct(ctx context.Context, productID string, name string, price float64, quantity int32) (bool, string, error) {
-	slog.InfoContext(ctx, "Updating product", "product_id", productID)
-	product := models.ProductStock{
-		ProductID: productID,
-		Name:      name,
-		Price:     price,
-		Quantity:  quantity,
+// UpdateProduct replaces a product's catalog fields. Stock is never touched; use AdjustStock.
+// version is the version the caller last read, unless anyVersion skips the check; a stale or
+// missing one is returned as an error rather than a failed outcome, since the caller has to
+// reload before retrying.
+func (s *inventoryService) UpdateProduct(ctx context.Context, productID string, version int64, anyVersion bool, name string, description string, price models.Money) (bool, string, error) {
+	slog.InfoContext(ctx, "Updating product", "product_id", productID, "version", version, "any_version", anyVersion)
+	_, err := s.PatchProduct(ctx, productID, models.ProductPatch{Version: version, AnyVersion: anyVersion, Name: &name, Description: &description, Price: &price})
+	if errors.Is(err, ErrVersionRequired) || errors.Is(err, repository.ErrVersionConflict) {
+		return false, err.Error(), err
 	}
-	err := s.repo.UpdateProduct(ctx, product)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to update product", "error", err)
 		return false, err.Error(), nil
@@ -72,14 +180,123 @@ func (s *inventoryService) UpdateProduct(ctx context.Context, productID string,
 	return true, "Product updated successfully", nil
 }
 
+// PatchProduct changes only the catalog fields set in patch, provided patch.Version is current.
+// A version is required, and must be positive, unless patch.AnyVersion is set.
+func (s *inventoryService) PatchProduct(ctx context.Context, productID string, patch models.ProductPatch) (models.ProductStock, error) {
+	slog.InfoContext(ctx, "Patching product", "product_id", productID, "version", patch.Version, "any_version", patch.AnyVersion)
+	if !patch.AnyVersion && patch.Version <= 0 {
+		return models.ProductStock{}, ErrVersionRequired
+	}
+	if patch.Price != nil && !patch.Price.Valid() {
+		return models.ProductStock{}, ErrInvalidPrice
+	}
+	product, err := s.repo.PatchProduct(ctx, productID, patch)
+	if errors.Is(err, repository.ErrVersionConflict) {
+		slog.WarnContext(ctx, "Stale product update", "product_id", productID, "error", err)
+	}
+	return product, err
+}
+
+// AdjustStock corrects a product's available stock by delta, e.g. after a stock count or
+// a write-off. The reason is only logged.
+func (s *inventoryService) AdjustStock(ctx context.Context, productID string, delta int32, reason string) (models.ProductStock, error) {
+	slog.InfoContext(ctx, "Adjusting stock", "product_id", productID, "delta", delta, "reason", reason)
+	if delta == 0 {
+		return models.ProductStock{}, ErrInvalidAdjustment
+	}
+	return s.repo.AdjustStock(ctx, productID, delta)
+}
+
+// DeleteProduct archives the product rather than removing it, so reservations that still
+// hold its stock can be released. See PurgeProduct for permanent removal.
 func (s *inventoryService) DeleteProduct(ctx context.Context, productID string) (bool, string, error) {
-	slog.WarnContext(ctx, "Deleting product", "product_id", productID)
-	err := s.repo.DeleteProduct(ctx, productID)
+	slog.WarnContext(ctx, "Archiving product", "product_id", productID)
+	err := s.repo.ArchiveProduct(ctx, productID)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to delete product", "error", err)
+		slog.ErrorContext(ctx, "Failed to archive product", "error", err)
 		return false, err.Error(), nil
 	}
-	return true, "Product deleted successfully", nil
+	return true, "Product archived successfully", nil
+}
+
+func (s *inventoryService) RestoreProduct(ctx context.Context, productID string) (models.ProductStock, error) {
+	slog.InfoContext(ctx, "Restoring product", "product_id", productID)
+	return s.repo.RestoreProduct(ctx, productID)
+}
+
+// PurgeProduct permanently deletes an archived product once no reservation holds it. Its
+// image files are deleted on a best-effort basis once the rows are gone.
+func (s *inventoryService) PurgeProduct(ctx context.Context, productID string) error {
+	slog.WarnContext(ctx, "Purging product", "product_id", productID)
+	images, err := s.repo.PurgeProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if s.images != nil {
+		for _, img := range images {
+			deleteImageFiles(ctx, s.images, img)
+		}
+	}
+	return nil
+}
+
+// CreateVariant adds a variant SKU under parentID with its own option values and stock. The
+// price override is in minor units of the parent's currency; nil sells at the parent's price.
+func (s *inventoryService) CreateVariant(ctx context.Context, parentID string, productID string, name string, options models.OptionValues, priceOverride *int64, quantity int32) (models.ProductStock, error) {
+	slog.InfoContext(ctx, "Creating variant", "parent_id", parentID, "product_id", productID, "options", options)
+	if productID == "" || len(options) == 0 {
+		return models.ProductStock{}, ErrInvalidVariant
+	}
+	if priceOverride != nil && *priceOverride < 0 {
+		return models.ProductStock{}, ErrInvalidPrice
+	}
+	return s.repo.CreateVariant(ctx, models.ProductStock{
+		ProductID:         productID,
+		ParentID:          &parentID,
+		Name:              name,
+		Options:           options,
+		UnitPriceOverride: priceOverride,
+		Quantity:          quantity,
+	})
+}
+
+func (s *inventoryService) UpdateVariant(ctx context.Context, productID string, options models.OptionValues, priceOverride *int64) (models.ProductStock, error) {
+	slog.InfoContext(ctx, "Updating variant", "product_id", productID, "options", options)
+	if len(options) == 0 {
+		return models.ProductStock{}, ErrInvalidVariant
+	}
+	if priceOverride != nil && *priceOverride < 0 {
+		return models.ProductStock{}, ErrInvalidPrice
+	}
+	return s.repo.UpdateVariant(ctx, productID, options, priceOverride)
+}
+
+func (s *inventoryService) ListVariants(ctx context.Context, parentID string) ([]models.ProductStock, error) {
+	return s.repo.ListVariants(ctx, parentID)
+}
+
+// SetBundleComponents makes a product a bundle of the given components, replacing any it had;
+// no components make it an ordinary product again. A bundle holds no stock of its own:
+// reserving it reserves its components, and it is available as often as they allow.
+func (s *inventoryService) SetBundleComponents(ctx context.Context, bundleID string, components []models.BundleComponent) (models.ProductStock, error) {
+	slog.InfoContext(ctx, "Setting bundle components", "product_id", bundleID, "components", len(components))
+	if err := checkBundleComponents(bundleID, components); err != nil {
+		return models.ProductStock{}, err
+	}
+	return s.repo.SetBundleComponents(ctx, bundleID, components)
+}
+
+// checkBundleComponents refuses components without a SKU or a positive quantity, the bundle
+// itself, and the same component twice.
+func checkBundleComponents(bundleID string, components []models.BundleComponent) error {
+	seen := make(map[string]bool, len(components))
+	for _, component := range components {
+		if component.ComponentID == "" || component.ComponentID == bundleID || component.Quantity <= 0 || seen[component.ComponentID] {
+			return ErrInvalidBundle
+		}
+		seen[component.ComponentID] = true
+	}
+	return nil
 }
 
 func (s *inventoryService) RestockItems(ctx context.Context, productID string, quantity int32) (bool, string, error) {
@@ -91,9 +308,17 @@ func (s *inventoryService) RestockItems(ctx context.Context, productID string, q
 	return true, "Stock restocked successfully", nil
 }
 
-func (s *inventoryService) Reserve(ctx context.Context, orderID string, productID string, quantity int32) (bool, string, error) {
+func (s *inventoryService) Reserve(ctx context.Context, orderID string, productID string, quantity int32, ttl time.Duration) (bool, string, error) {
 	slog.InfoContext(ctx, "Reserving stock", "order_id", orderID, "product_id", productID, "quantity", quantity)
-	err := s.repo.ReserveStock(ctx, orderID, productID, quantity)
+	if quantity <= 0 {
+		return false, repository.ErrInvalidQuantity.Error(), repository.ErrInvalidQuantity
+	}
+	err := s.repo.ReserveStock(ctx, orderID, productID, quantity, s.expiresAt(ttl))
+	if errors.Is(err, repository.ErrIdempotencyConflict) {
+		// Reusing an order ID for a different request is a caller bug, not a stock outcome
+		slog.WarnContext(ctx, "Idempotency conflict", "order_id", orderID)
+		return false, err.Error(), err
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to reserve stock", "error", err, "order_id", orderID)
 		return false, err.Error(), nil
@@ -103,30 +328,171 @@ func (s *inventoryService) Reserve(ctx context.Context, orderID string, productI
 
 func (s *inventoryService) Release(ctx context.Context, orderID string, productID string, quantity int32) (bool, string, error) {
 	slog.InfoContext(ctx, "Releasing stock", "order_id", orderID, "product_id", productID)
-	err := s.repo.ReleaseStock(ctx, orderID, productID, quantity)
+	released, err := s.repo.ReleaseStock(ctx, orderID, productID)
 	if err != nil {
 		return false, err.Error(), nil
 	}
+	if released > 0 && released != quantity {
+		// The ledger is authoritative; the caller's quantity is only checked, never applied.
+		slog.WarnContext(ctx, "Release quantity does not match reservation", "order_id", orderID, "product_id", productID, "requested", quantity, "released", released)
+	}
 	return true, "Stock released successfully", nil
 }
 
-func (s *inventoryService) BatchReserve(ctx context.Context, orderID string, items []models.BatchItem) (bool, string, error) {
-	slog.InfoContext(ctx, "Batch reserving stock", "order_id", orderID, "item_count", len(items))
-	err := s.repo.BatchReserveStock(ctx, orderID, items)
+func (s *inventoryService) BatchReserve(ctx context.Context, orderID string, items []models.BatchItem, ttl time.Duration, allowPartial bool) (bool, string, []models.BatchItemResult, error) {
+	slog.InfoContext(ctx, "Batch reserving stock", "order_id", orderID, "item_count", len(items), "allow_partial", allowPartial)
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return false, repository.ErrInvalidQuantity.Error(), nil, repository.ErrInvalidQuantity
+		}
+	}
+	results, err := s.repo.BatchReserveStock(ctx, orderID, items, s.expiresAt(ttl), allowPartial)
+	if errors.Is(err, repository.ErrIdempotencyConflict) {
+		slog.WarnContext(ctx, "Idempotency conflict", "order_id", orderID)
+		return false, err.Error(), nil, err
+	}
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed batch stock reservation", "error", err, "order_id", orderID)
-		return false, err.Error(), nil
+		slog.ErrorContext(ctx, "Failed batch stock reservation", "error", err, "order_id", orderID, "results", results)
+		return false, err.Error(), results, nil
+	}
+	for _, result := range results {
+		if result.Granted < result.Requested {
+			slog.WarnContext(ctx, "Batch stock partially reserved", "order_id", orderID, "results", results)
+			return true, "Batch stock partially reserved", results, nil
+		}
 	}
-	return true, "Batch stock reserved successfully", nil
+	return true, "Batch stock reserved successfully", results, nil
 }
 
-func (s *inventoryService) BatchRelease(ctx context.Context, orderID string, items []models.BatchItem) (bool, string, error) {
+func (s *inventoryService) BatchRelease(ctx context.Context, orderID string, items []models.BatchItem) (bool, string, []models.BatchItemResult, error) {
 	slog.InfoContext(ctx, "Batch releasing stock", "order_id", orderID, "item_count", len(items))
-	err := s.repo.BatchReleaseStock(ctx, orderID, items)
+	released, err := s.repo.BatchReleaseStock(ctx, orderID)
+	if err != nil {
+		return false, err.Error(), nil, nil
+	}
+	results, matched := releaseResults(items, released)
+	if len(released) > 0 && !matched {
+		slog.WarnContext(ctx, "Batch release items do not match reservation", "order_id", orderID, "results", results)
+	}
+	return true, "Batch stock released successfully", results, nil
+}
+
+func (s *inventoryService) ConfirmReservation(ctx context.Context, orderID string) (bool, string, error) {
+	slog.InfoContext(ctx, "Confirming reservation", "order_id", orderID)
+	if err := s.repo.ConfirmReservation(ctx, orderID); err != nil {
+		slog.ErrorContext(ctx, "Failed to confirm reservation", "error", err, "order_id", orderID)
+		return false, err.Error(), nil
+	}
+	return true, "Reservation confirmed successfully", nil
+}
+
+func (s *inventoryService) CancelReservation(ctx context.Context, orderID string) (bool, string, error) {
+	slog.InfoContext(ctx, "Cancelling reservation", "order_id", orderID)
+	released, err := s.repo.CancelReservation(ctx, orderID)
 	if err != nil {
+		slog.ErrorContext(ctx, "Failed to cancel reservation", "error", err, "order_id", orderID)
 		return false, err.Error(), nil
 	}
-	return true, "Batch stock released successfully", nil
+	slog.InfoContext(ctx, "Reservation cancelled", "order_id", orderID, "release_reason", "cancelled", "released", released)
+	return true, "Reservation cancelled successfully", nil
+}
+
+func (s *inventoryService) GetReservation(ctx context.Context, orderID string) (models.ReservationSummary, error) {
+	lines, err := s.repo.GetReservation(ctx, orderID)
+	if err != nil {
+		return models.ReservationSummary{}, err
+	}
+	return summarizeReservation(orderID, lines), nil
+}
+
+// summarizeReservation folds ledger lines into one order-level view. The order is pending while
+// any line is still held, confirmed once something was sold, and released otherwise.
+func summarizeReservation(orderID string, lines []models.Reservation) models.ReservationSummary {
+	summary := models.ReservationSummary{OrderID: orderID, Status: models.ReservationReleased, Lines: lines}
+	for i, line := range lines {
+		switch {
+		case line.Status == models.ReservationPending:
+			summary.Status = models.ReservationPending
+		case line.Status == models.ReservationConfirmed && summary.Status != models.ReservationPending:
+			summary.Status = models.ReservationConfirmed
+		}
+		if i == 0 || line.CreatedAt.Before(summary.CreatedAt) {
+			summary.CreatedAt = line.CreatedAt
+		}
+		if line.UpdatedAt.After(summary.UpdatedAt) {
+			summary.UpdatedAt = line.UpdatedAt
+		}
+		if line.ExpiresAt.After(summary.ExpiresAt) {
+			summary.ExpiresAt = line.ExpiresAt
+		}
+	}
+	return summary
+}
+
+func (s *inventoryService) ListExpiredReservations(ctx context.Context, limit int) ([]string, error) {
+	return s.repo.ListExpiredReservations(ctx, time.Now(), limit)
+}
+
+// ExpireReservation releases the pending lines of an order whose reservation outlived its TTL.
+// It shares the ledger release path with Release, so only what was reserved is returned.
+// The released lines are empty when the order was confirmed or released since it was listed.
+func (s *inventoryService) ExpireReservation(ctx context.Context, orderID string) ([]models.BatchItemResult, error) {
+	released, err := s.repo.ExpireReservation(ctx, orderID, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to auto-release expired reservation", "error", err, "order_id", orderID, "release_reason", "expired")
+		return nil, err
+	}
+	if len(released) > 0 {
+		slog.WarnContext(ctx, "Auto-released expired reservation", "order_id", orderID, "release_reason", "expired", "released", released)
+	}
+	return released, nil
+}
+
+// PurgeCompletedReservations removes one batch of settled orders older than the retention
+// window and returns the number of idempotency records and ledger lines deleted.
+func (s *inventoryService) PurgeCompletedReservations(ctx context.Context, limit int) (int64, int64, error) {
+	records, lines, err := s.repo.PurgeCompletedReservations(ctx, time.Now().Add(-s.retention), limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to purge completed reservations", "error", err)
+		return 0, 0, err
+	}
+	if records > 0 {
+		slog.InfoContext(ctx, "Purged completed reservations", "records", records, "lines", lines)
+	}
+	return records, lines, nil
+}
+
+// releaseResults lines up what the caller asked to release with what the ledger returned.
+// Requested products the order did not hold are reported as NOT_FOUND; matched is false
+// whenever the caller's quantities disagree with the ledger.
+func releaseResults(requested []models.BatchItem, released []models.BatchItemResult) ([]models.BatchItemResult, bool) {
+	asked := make(map[string]int32)
+	var order []string
+	for _, item := range requested {
+		if _, seen := asked[item.ProductID]; !seen {
+			order = append(order, item.ProductID)
+		}
+		asked[item.ProductID] += item.Quantity
+	}
+
+	matched := true
+	var results []models.BatchItemResult
+	for _, result := range released {
+		quantity, ok := asked[result.ProductID]
+		delete(asked, result.ProductID)
+		result.Requested = quantity
+		if !ok || quantity != result.Granted {
+			matched = false
+		}
+		results = append(results, result)
+	}
+	for _, productID := range order {
+		if quantity, ok := asked[productID]; ok {
+			matched = false
+			results = append(results, models.BatchItemResult{ProductID: productID, Status: models.ItemNotFound, Requested: quantity})
+		}
+	}
+	return results, matched
 }
 
 func (s *inventoryService) GetProduct(ctx context.Context, productID string) (models.ProductStock, error) {
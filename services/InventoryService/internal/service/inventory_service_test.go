@@ -3,9 +3,14 @@ package service
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -15,16 +20,56 @@ type MockRepository struct {
 	mock.Mock
 }
 
-func (m *MockRepository) ReserveStock(ctx context.Context, orderID string, productID string, quantity int32) error {
-	args := m.Called(ctx, orderID, productID, quantity)
+func (m *MockRepository) ReserveStock(ctx context.Context, orderID string, productID string, quantity int32, expiresAt time.Time) error {
+	args := m.Called(ctx, orderID, productID, quantity, expiresAt)
 	return args.Error(0)
 }
 
-func (m *MockRepository) ReleaseStock(ctx context.Context, orderID string, productID string, quantity int32) error {
-	args := m.Called(ctx, orderID, productID, quantity)
+func (m *MockRepository) ReleaseStock(ctx context.Context, orderID string, productID string) (int32, error) {
+	args := m.Called(ctx, orderID, productID)
+	return int32(args.Int(0)), args.Error(1)
+}
+
+func (m *MockRepository) BatchReserveStock(ctx context.Context, orderID string, items []models.BatchItem, expiresAt time.Time, allowPartial bool) ([]models.BatchItemResult, error) {
+	args := m.Called(ctx, orderID, items, expiresAt, allowPartial)
+	return args.Get(0).([]models.BatchItemResult), args.Error(1)
+}
+
+func (m *MockRepository) BatchReleaseStock(ctx context.Context, orderID string) ([]models.BatchItemResult, error) {
+	args := m.Called(ctx, orderID)
+	return args.Get(0).([]models.BatchItemResult), args.Error(1)
+}
+
+func (m *MockRepository) ConfirmReservation(ctx context.Context, orderID string) error {
+	args := m.Called(ctx, orderID)
 	return args.Error(0)
 }
 
+func (m *MockRepository) CancelReservation(ctx context.Context, orderID string) ([]models.BatchItemResult, error) {
+	args := m.Called(ctx, orderID)
+	return args.Get(0).([]models.BatchItemResult), args.Error(1)
+}
+
+func (m *MockRepository) ExpireReservation(ctx context.Context, orderID string, now time.Time) ([]models.BatchItemResult, error) {
+	args := m.Called(ctx, orderID, now)
+	return args.Get(0).([]models.BatchItemResult), args.Error(1)
+}
+
+func (m *MockRepository) PurgeCompletedReservations(ctx context.Context, before time.Time, limit int) (int64, int64, error) {
+	args := m.Called(ctx, before, limit)
+	return args.Get(0).(int64), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRepository) GetReservation(ctx context.Context, orderID string) ([]models.Reservation, error) {
+	args := m.Called(ctx, orderID)
+	return args.Get(0).([]models.Reservation), args.Error(1)
+}
+
+func (m *MockRepository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *MockRepository) GetStock(ctx context.Context, productID string) (int32, error) {
 	args := m.Called(ctx, productID)
 	return int32(args.Int(0)), args.Error(1)
@@ -35,9 +80,14 @@ func (m *MockRepository) GetProduct(ctx context.Context, productID string) (mode
 	return args.Get(0).(models.ProductStock), args.Error(1)
 }
 
-func (m *MockRepository) ListProducts(ctx context.Context) ([]models.ProductStock, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]models.ProductStock), args.Error(1)
+func (m *MockRepository) ListProducts(ctx context.Context, query models.ProductQuery) (models.ProductPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(models.ProductPage), args.Error(1)
+}
+
+func (m *MockRepository) SearchProducts(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(models.SearchPage), args.Error(1)
 }
 
 func (m *MockRepository) CreateProduct(ctx context.Context, product models.ProductStock) error {
@@ -45,30 +95,65 @@ func (m *MockRepository) CreateProduct(ctx context.Context, product models.Produ
 	return args.Error(0)
 }
 
-func (m *MockRepository) UpdateProduct(ctx context.Context, product models.ProductStock) error {
-	args := m.Called(ctx, product)
-	return args.Error(0)
+func (m *MockRepository) PatchProduct(ctx context.Context, productID string, patch models.ProductPatch) (models.ProductStock, error) {
+	args := m.Called(ctx, productID, patch)
+	return args.Get(0).(models.ProductStock), args.Error(1)
+}
+
+func (m *MockRepository) AdjustStock(ctx context.Context, productID string, delta int32) (models.ProductStock, error) {
+	args := m.Called(ctx, productID, delta)
+	return args.Get(0).(models.ProductStock), args.Error(1)
 }
 
-func (m *MockRepository) DeleteProduct(ctx context.Context, productID string) error {
+func (m *MockRepository) ArchiveProduct(ctx context.Context, productID string) error {
 	args := m.Called(ctx, productID)
 	return args.Error(0)
 }
 
+func (m *MockRepository) RestoreProduct(ctx context.Context, productID string) (models.ProductStock, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).(models.ProductStock), args.Error(1)
+}
+
+func (m *MockRepository) PurgeProduct(ctx context.Context, productID string) ([]models.ProductImage, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).([]models.ProductImage), args.Error(1)
+}
+
+func (m *MockRepository) CreateVariant(ctx context.Context, variant models.ProductStock) (models.ProductStock, error) {
+	args := m.Called(ctx, variant)
+	return args.Get(0).(models.ProductStock), args.Error(1)
+}
+
+func (m *MockRepository) UpdateVariant(ctx context.Context, productID string, options models.OptionValues, priceOverride *int64) (models.ProductStock, error) {
+	args := m.Called(ctx, productID, options, priceOverride)
+	return args.Get(0).(models.ProductStock), args.Error(1)
+}
+
+func (m *MockRepository) ListVariants(ctx context.Context, parentID string) ([]models.ProductStock, error) {
+	args := m.Called(ctx, parentID)
+	return args.Get(0).([]models.ProductStock), args.Error(1)
+}
+
 func (m *MockRepository) RestockItems(ctx context.Context, productID string, quantity int32) error {
 	args := m.Called(ctx, productID, quantity)
 	return args.Error(0)
 }
 
+func (m *MockRepository) SetBundleComponents(ctx context.Context, bundleID string, components []models.BundleComponent) (models.ProductStock, error) {
+	args := m.Called(ctx, bundleID, components)
+	return args.Get(0).(models.ProductStock), args.Error(1)
+}
+
 func TestInventoryService_Reserve(t *testing.T) {
 	mockRepo := new(MockRepository)
 	svc := NewInventoryService(mockRepo)
 	ctx := context.Background()
 
 	t.Run("Successful Reservation", func(t *testing.T) {
-		mockRepo.On("ReserveStock", ctx, "order-1", "prod-1", int32(5)).Return(nil).Once()
+		mockRepo.On("ReserveStock", ctx, "order-1", "prod-1", int32(5), mock.AnythingOfType("time.Time")).Return(nil).Once()
 
-		success, msg, err := svc.Reserve(ctx, "order-1", "prod-1", 5)
+		success, msg, err := svc.Reserve(ctx, "order-1", "prod-1", 5, 0)
 
 		assert.NoError(t, err)
 		assert.True(t, success)
@@ -77,15 +162,197 @@ func TestInventoryService_Reserve(t *testing.T) {
 	})
 
 	t.Run("Insufficient Stock", func(t *testing.T) {
-		mockRepo.On("ReserveStock", ctx, "order-2", "prod-1", int32(500)).Return(errors.New("insufficient stock")).Once()
+		mockRepo.On("ReserveStock", ctx, "order-2", "prod-1", int32(500), mock.AnythingOfType("time.Time")).Return(errors.New("insufficient stock")).Once()
 
-		success, msg, err := svc.Reserve(ctx, "order-2", "prod-1", 500)
+		success, msg, err := svc.Reserve(ctx, "order-2", "prod-1", 500, 0)
 
 		assert.NoError(t, err)
 		assert.False(t, success)
 		assert.Equal(t, "insufficient stock", msg)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("Conflicting Replay Is An Error", func(t *testing.T) {
+		mockRepo.On("ReserveStock", ctx, "order-1", "prod-2", int32(5), mock.AnythingOfType("time.Time")).Return(repository.ErrIdempotencyConflict).Once()
+
+		success, _, err := svc.Reserve(ctx, "order-1", "prod-2", 5, 0)
+
+		assert.ErrorIs(t, err, repository.ErrIdempotencyConflict)
+		assert.False(t, success)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Non-Positive Quantity Is Rejected", func(t *testing.T) {
+		for _, quantity := range []int32{0, -3} {
+			success, _, err := svc.Reserve(ctx, "order-5", "prod-1", quantity, 0)
+
+			assert.ErrorIs(t, err, repository.ErrInvalidQuantity)
+			assert.False(t, success)
+		}
+		mockRepo.AssertNotCalled(t, "ReserveStock", ctx, "order-5", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Expiry Uses Requested TTL Or Default", func(t *testing.T) {
+		svc := NewInventoryService(mockRepo, WithReservationTTL(time.Hour))
+		before := time.Now()
+
+		inWindow := func(ttl time.Duration) interface{} {
+			return mock.MatchedBy(func(expiresAt time.Time) bool {
+				return !expiresAt.Before(before.Add(ttl)) && expiresAt.Before(time.Now().Add(ttl+time.Second))
+			})
+		}
+		mockRepo.On("ReserveStock", ctx, "order-3", "prod-1", int32(1), inWindow(time.Hour)).Return(nil).Once()
+		mockRepo.On("ReserveStock", ctx, "order-4", "prod-1", int32(1), inWindow(30*time.Second)).Return(nil).Once()
+
+		svc.Reserve(ctx, "order-3", "prod-1", 1, 0)
+		svc.Reserve(ctx, "order-4", "prod-1", 1, 30*time.Second)
+
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInventoryService_ConfirmAndCancel(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewInventoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Confirm Pending Reservation", func(t *testing.T) {
+		mockRepo.On("ConfirmReservation", ctx, "order-1").Return(nil).Once()
+
+		success, msg, err := svc.ConfirmReservation(ctx, "order-1")
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		assert.Equal(t, "Reservation confirmed successfully", msg)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Cancel Pending Reservation", func(t *testing.T) {
+		mockRepo.On("CancelReservation", ctx, "order-2").Return([]models.BatchItemResult{{ProductID: "prod-1", Status: models.ItemOK, Requested: 2, Granted: 2}}, nil).Once()
+
+		success, msg, err := svc.CancelReservation(ctx, "order-2")
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		assert.Equal(t, "Reservation cancelled successfully", msg)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Cancel Confirmed Reservation Fails", func(t *testing.T) {
+		mockRepo.On("CancelReservation", ctx, "order-3").Return([]models.BatchItemResult(nil), repository.ErrReservationConfirmed).Once()
+
+		success, msg, err := svc.CancelReservation(ctx, "order-3")
+
+		assert.NoError(t, err)
+		assert.False(t, success)
+		assert.Equal(t, "reservation already confirmed", msg)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInventoryService_GetReservation(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewInventoryService(mockRepo)
+	ctx := context.Background()
+	t0 := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Pending While Any Line Is Held", func(t *testing.T) {
+		lines := []models.Reservation{
+			{OrderID: "order-1", ProductID: "prod-1", Quantity: 2, Status: models.ReservationReleased, CreatedAt: t0, UpdatedAt: t0.Add(time.Minute), ExpiresAt: t0.Add(15 * time.Minute)},
+			{OrderID: "order-1", ProductID: "prod-2", Quantity: 1, Status: models.ReservationPending, CreatedAt: t0, UpdatedAt: t0, ExpiresAt: t0.Add(15 * time.Minute)},
+		}
+		mockRepo.On("GetReservation", ctx, "order-1").Return(lines, nil).Once()
+
+		summary, err := svc.GetReservation(ctx, "order-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReservationPending, summary.Status)
+		assert.Len(t, summary.Lines, 2)
+		assert.Equal(t, t0, summary.CreatedAt)
+		assert.Equal(t, t0.Add(time.Minute), summary.UpdatedAt)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Confirmed Once Nothing Is Pending", func(t *testing.T) {
+		lines := []models.Reservation{
+			{OrderID: "order-2", ProductID: "prod-1", Quantity: 2, Status: models.ReservationConfirmed},
+			{OrderID: "order-2", ProductID: "prod-2", Quantity: 1, Status: models.ReservationReleased},
+		}
+		mockRepo.On("GetReservation", ctx, "order-2").Return(lines, nil).Once()
+
+		summary, err := svc.GetReservation(ctx, "order-2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReservationConfirmed, summary.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Order", func(t *testing.T) {
+		mockRepo.On("GetReservation", ctx, "order-3").Return([]models.Reservation(nil), repository.ErrReservationNotFound).Once()
+
+		_, err := svc.GetReservation(ctx, "order-3")
+
+		assert.ErrorIs(t, err, repository.ErrReservationNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInventoryService_ExpireReservation(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewInventoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Releases Through The Ledger", func(t *testing.T) {
+		lines := []models.BatchItemResult{{ProductID: "prod-1", Status: models.ItemOK, Requested: 3, Granted: 3}}
+		mockRepo.On("ExpireReservation", ctx, "order-1", mock.AnythingOfType("time.Time")).Return(lines, nil).Once()
+
+		released, err := svc.ExpireReservation(ctx, "order-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, lines, released)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Settled Since Listed Releases Nothing", func(t *testing.T) {
+		mockRepo.On("ExpireReservation", ctx, "order-2", mock.AnythingOfType("time.Time")).Return([]models.BatchItemResult(nil), nil).Once()
+
+		released, err := svc.ExpireReservation(ctx, "order-2")
+
+		assert.NoError(t, err)
+		assert.Empty(t, released)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockRepo.On("ExpireReservation", ctx, "order-3", mock.AnythingOfType("time.Time")).Return([]models.BatchItemResult(nil), errors.New("db down")).Once()
+
+		released, err := svc.ExpireReservation(ctx, "order-3")
+
+		assert.EqualError(t, err, "db down")
+		assert.Nil(t, released)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInventoryService_PurgeCompletedReservations(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewInventoryService(mockRepo, WithRetention(48*time.Hour))
+	ctx := context.Background()
+
+	t.Run("Cutoff Honours Retention", func(t *testing.T) {
+		before := time.Now()
+		cutoff := mock.MatchedBy(func(t time.Time) bool {
+			return !t.Before(before.Add(-48*time.Hour)) && t.Before(time.Now().Add(-47*time.Hour))
+		})
+		mockRepo.On("PurgeCompletedReservations", ctx, cutoff, 100).Return(int64(2), int64(5), nil).Once()
+
+		records, lines, err := svc.PurgeCompletedReservations(ctx, 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), records)
+		assert.Equal(t, int64(5), lines)
+		mockRepo.AssertExpectations(t)
+	})
 }
 
 func TestInventoryService_Release(t *testing.T) {
@@ -94,7 +361,7 @@ func TestInventoryService_Release(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Successful Release", func(t *testing.T) {
-		mockRepo.On("ReleaseStock", ctx, "order-1", "prod-1", int32(5)).Return(nil).Once()
+		mockRepo.On("ReleaseStock", ctx, "order-1", "prod-1").Return(5, nil).Once()
 
 		success, msg, err := svc.Release(ctx, "order-1", "prod-1", 5)
 
@@ -103,6 +370,120 @@ func TestInventoryService_Release(t *testing.T) {
 		assert.Equal(t, "Stock released successfully", msg)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("Caller Quantity Is Not Applied", func(t *testing.T) {
+		// The ledger holds 5; an inflated request must not change what is released
+		mockRepo.On("ReleaseStock", ctx, "order-2", "prod-1").Return(5, nil).Once()
+
+		success, _, err := svc.Release(ctx, "order-2", "prod-1", 500)
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInventoryService_BatchReserve(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewInventoryService(mockRepo)
+	ctx := context.Background()
+	items := []models.BatchItem{{ProductID: "prod-1", Quantity: 2}, {ProductID: "prod-2", Quantity: 5}}
+
+	t.Run("All Or Nothing", func(t *testing.T) {
+		results := []models.BatchItemResult{{ProductID: "prod-1", Requested: 2, Granted: 2}, {ProductID: "prod-2", Requested: 5, Granted: 5}}
+		mockRepo.On("BatchReserveStock", ctx, "order-1", items, mock.AnythingOfType("time.Time"), false).Return(results, nil).Once()
+
+		success, msg, got, err := svc.BatchReserve(ctx, "order-1", items, 0, false)
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		assert.Equal(t, "Batch stock reserved successfully", msg)
+		assert.Equal(t, results, got)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Partial Fulfilment", func(t *testing.T) {
+		results := []models.BatchItemResult{{ProductID: "prod-1", Requested: 2, Granted: 2}, {ProductID: "prod-2", Requested: 5, Granted: 3}}
+		mockRepo.On("BatchReserveStock", ctx, "order-2", items, mock.AnythingOfType("time.Time"), true).Return(results, nil).Once()
+
+		success, msg, got, err := svc.BatchReserve(ctx, "order-2", items, 0, true)
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		assert.Equal(t, "Batch stock partially reserved", msg)
+		assert.Equal(t, int32(3), got[1].Granted)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rejected With Per-Item Results", func(t *testing.T) {
+		results := []models.BatchItemResult{
+			{ProductID: "prod-1", Status: models.ItemOK, Requested: 2, Available: 10},
+			{ProductID: "prod-2", Status: models.ItemInsufficient, Requested: 5, Available: 3},
+		}
+		mockRepo.On("BatchReserveStock", ctx, "order-3", items, mock.AnythingOfType("time.Time"), false).
+			Return(results, errors.New("insufficient stock for product prod-2")).Once()
+
+		success, msg, got, err := svc.BatchReserve(ctx, "order-3", items, 0, false)
+
+		assert.NoError(t, err)
+		assert.False(t, success)
+		assert.Equal(t, "insufficient stock for product prod-2", msg)
+		assert.Equal(t, models.ItemInsufficient, got[1].Status)
+		assert.Equal(t, int32(3), got[1].Available)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Non-Positive Line Is Rejected", func(t *testing.T) {
+		for _, quantity := range []int32{0, -3} {
+			lines := []models.BatchItem{{ProductID: "prod-1", Quantity: 2}, {ProductID: "prod-2", Quantity: quantity}}
+
+			success, _, got, err := svc.BatchReserve(ctx, "order-4", lines, 0, true)
+
+			assert.ErrorIs(t, err, repository.ErrInvalidQuantity)
+			assert.False(t, success)
+			assert.Nil(t, got)
+		}
+		mockRepo.AssertNotCalled(t, "BatchReserveStock", ctx, "order-4", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestInventoryService_BatchRelease(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewInventoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Releases Ledger Lines", func(t *testing.T) {
+		released := []models.BatchItemResult{
+			{ProductID: "prod-1", Status: models.ItemOK, Requested: 2, Granted: 2, Available: 10},
+			{ProductID: "prod-2", Status: models.ItemOK, Requested: 1, Granted: 1, Available: 4},
+		}
+		mockRepo.On("BatchReleaseStock", ctx, "order-1").Return(released, nil).Once()
+
+		items := []models.BatchItem{{ProductID: "prod-1", Quantity: 2}, {ProductID: "prod-2", Quantity: 1}}
+		success, msg, results, err := svc.BatchRelease(ctx, "order-1", items)
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		assert.Equal(t, "Batch stock released successfully", msg)
+		assert.Equal(t, released, results)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReleaseResults(t *testing.T) {
+	requested := []models.BatchItem{{ProductID: "p1", Quantity: 1}, {ProductID: "p1", Quantity: 2}, {ProductID: "p3", Quantity: 4}}
+	released := []models.BatchItemResult{{ProductID: "p1", Status: models.ItemOK, Requested: 3, Granted: 3}}
+
+	results, matched := releaseResults(requested, released)
+
+	assert.False(t, matched)
+	assert.Equal(t, []models.BatchItemResult{
+		{ProductID: "p1", Status: models.ItemOK, Requested: 3, Granted: 3},
+		{ProductID: "p3", Status: models.ItemNotFound, Requested: 4},
+	}, results)
+
+	_, matched = releaseResults(requested[:2], released)
+	assert.True(t, matched)
 }
 
 func TestInventoryService_GetStock(t *testing.T) {
@@ -145,14 +526,16 @@ func TestInventoryService_ProductManagement(t *testing.T) {
 
 	t.Run("Create Product", func(t *testing.T) {
 		product := models.ProductStock{
-			ProductID: "new-prod",
-			Name:      "Test Product",
-			Price:     99.99,
-			Quantity:  10,
+			ProductID:   "new-prod",
+			Name:        "Test Product",
+			Description: "Searchable description",
+			UnitPrice:   models.Money{MinorUnits: 9999, Currency: "USD"},
+			Price:       99.99,
+			Quantity:    10,
 		}
 		mockRepo.On("CreateProduct", ctx, product).Return(nil).Once()
 
-		success, msg, err := svc.CreateProduct(ctx, "new-prod", "Test Product", 99.99, 10)
+		success, msg, err := svc.CreateProduct(ctx, "new-prod", "Test Product", "Searchable description", models.Money{MinorUnits: 9999, Currency: "USD"}, 10)
 
 		assert.NoError(t, err)
 		assert.True(t, success)
@@ -160,17 +543,234 @@ func TestInventoryService_ProductManagement(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("Create Product Rejects Bad Currency", func(t *testing.T) {
+		success, msg, err := svc.CreateProduct(ctx, "bad-prod", "Bad", "", models.Money{MinorUnits: 100, Currency: "usd"}, 1)
+
+		assert.NoError(t, err)
+		assert.False(t, success)
+		assert.Equal(t, ErrInvalidPrice.Error(), msg)
+		mockRepo.AssertNotCalled(t, "CreateProduct", ctx, mock.MatchedBy(func(p models.ProductStock) bool { return p.ProductID == "bad-prod" }))
+	})
+
+	t.Run("Update Product Leaves Stock Alone", func(t *testing.T) {
+		name, description, price := "Renamed", "New copy", models.Money{MinorUnits: 8999, Currency: "USD"}
+		mockRepo.On("PatchProduct", ctx, "new-prod", models.ProductPatch{Version: 3, Name: &name, Description: &description, Price: &price}).Return(models.ProductStock{ProductID: "new-prod"}, nil).Once()
+
+		success, msg, err := svc.UpdateProduct(ctx, "new-prod", 3, false, "Renamed", "New copy", price)
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		assert.Equal(t, "Product updated successfully", msg)
+		mockRepo.AssertNotCalled(t, "AdjustStock", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Stale Update Is An Error", func(t *testing.T) {
+		mockRepo.On("PatchProduct", ctx, "new-prod", mock.AnythingOfType("models.ProductPatch")).Return(models.ProductStock{}, repository.ErrVersionConflict).Once()
+
+		success, _, err := svc.UpdateProduct(ctx, "new-prod", 2, false, "Renamed", "New copy", models.Money{MinorUnits: 8999, Currency: "USD"})
+
+		assert.False(t, success)
+		assert.ErrorIs(t, err, repository.ErrVersionConflict)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Patch Requires Version", func(t *testing.T) {
+		name := "Renamed"
+
+		for _, version := range []int64{0, -1, -7} {
+			_, err := svc.PatchProduct(ctx, "new-prod", models.ProductPatch{Version: version, Name: &name})
+
+			assert.ErrorIs(t, err, ErrVersionRequired, "version %d", version)
+		}
+		mockRepo.AssertNotCalled(t, "PatchProduct", ctx, "new-prod", models.ProductPatch{Version: -1, Name: &name})
+	})
+
+	t.Run("Update Requires Positive Version", func(t *testing.T) {
+		success, _, err := svc.UpdateProduct(ctx, "new-prod", -1, false, "Renamed", "New copy", models.Money{MinorUnits: 8999, Currency: "USD"})
+
+		assert.False(t, success)
+		assert.ErrorIs(t, err, ErrVersionRequired)
+	})
+
+	t.Run("Patch Accepts Any Version", func(t *testing.T) {
+		name := "Renamed"
+		patch := models.ProductPatch{AnyVersion: true, Name: &name}
+		mockRepo.On("PatchProduct", ctx, "new-prod", patch).Return(models.ProductStock{ProductID: "new-prod", Version: 5}, nil).Once()
+
+		product, err := svc.PatchProduct(ctx, "new-prod", patch)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), product.Version)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Adjust Stock", func(t *testing.T) {
+		mockRepo.On("AdjustStock", ctx, "new-prod", int32(-3)).Return(models.ProductStock{ProductID: "new-prod", Quantity: 7}, nil).Once()
+
+		product, err := svc.AdjustStock(ctx, "new-prod", -3, "damaged")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(7), product.Quantity)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Adjust Stock Rejects Zero Delta", func(t *testing.T) {
+		_, err := svc.AdjustStock(ctx, "new-prod", 0, "recount")
+
+		assert.ErrorIs(t, err, ErrInvalidAdjustment)
+	})
+
+	t.Run("Delete Archives Product", func(t *testing.T) {
+		mockRepo.On("ArchiveProduct", ctx, "old-prod").Return(nil).Once()
+
+		success, msg, err := svc.DeleteProduct(ctx, "old-prod")
+
+		assert.NoError(t, err)
+		assert.True(t, success)
+		assert.Equal(t, "Product archived successfully", msg)
+		mockRepo.AssertNotCalled(t, "PurgeProduct", mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Purge Refused With Open Reservations", func(t *testing.T) {
+		mockRepo.On("PurgeProduct", ctx, "old-prod").Return([]models.ProductImage(nil), repository.ErrOpenReservations).Once()
+
+		err := svc.PurgeProduct(ctx, "old-prod")
+
+		assert.ErrorIs(t, err, repository.ErrOpenReservations)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Purge Deletes Image Files", func(t *testing.T) {
+		dir := t.TempDir()
+		images := storage.NewLocalStorage(dir, "/media")
+		img := models.ProductImage{ProductID: "old-prod", ThumbnailKey: "products/old-prod/a-thumb.jpg", DetailKey: "products/old-prod/a-detail.jpg"}
+		assert.NoError(t, images.Put(ctx, img.ThumbnailKey, []byte("thumb"), "image/jpeg"))
+		assert.NoError(t, images.Put(ctx, img.DetailKey, []byte("detail"), "image/jpeg"))
+		purging := NewInventoryService(mockRepo, WithImageStorage(images))
+		mockRepo.On("PurgeProduct", ctx, "old-prod").Return([]models.ProductImage{img}, nil).Once()
+
+		err := purging.PurgeProduct(ctx, "old-prod")
+
+		assert.NoError(t, err)
+		entries, _ := os.ReadDir(filepath.Join(dir, "products", "old-prod"))
+		assert.Empty(t, entries)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("List Products", func(t *testing.T) {
 		products := []models.ProductStock{
-			{ProductID: "p1", Name: "P1", Price: 10, Quantity: 5},
+			{ProductID: "p1", Name: "P1", UnitPrice: models.Money{MinorUnits: 1000, Currency: "USD"}, Quantity: 5},
 		}
-		mockRepo.On("ListProducts", ctx).Return(products, nil).Once()
+		mockRepo.On("ListProducts", ctx, models.ProductQuery{PageSize: DefaultPageSize}).Return(models.ProductPage{Products: products}, nil).Once()
 
-		result, err := svc.ListProducts(ctx)
+		result, err := svc.ListProducts(ctx, models.ProductQuery{})
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-		assert.Equal(t, "p1", result[0].ProductID)
+		assert.Len(t, result.Products, 1)
+		assert.Equal(t, "p1", result.Products[0].ProductID)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("Search Products", func(t *testing.T) {
+		hits := []models.SearchHit{{ProductStock: models.ProductStock{ProductID: "p1", Name: "Wireless Keyboard"}, Rank: 0.8}}
+		mockRepo.On("SearchProducts", ctx, models.SearchQuery{Query: "keybord", PageSize: DefaultPageSize}).Return(models.SearchPage{Hits: hits, NextPageToken: "next"}, nil).Once()
+
+		result, err := svc.SearchProducts(ctx, models.SearchQuery{Query: "keybord"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, hits, result.Hits)
+		assert.Equal(t, "next", result.NextPageToken)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("List Products Clamps Page Size", func(t *testing.T) {
+		query := models.ProductQuery{PageSize: 10000, SortBy: models.SortByPrice, InStockOnly: true}
+		clamped := query
+		clamped.PageSize = MaxPageSize
+		mockRepo.On("ListProducts", ctx, clamped).Return(models.ProductPage{}, nil).Once()
+
+		_, err := svc.ListProducts(ctx, query)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInventoryService_CreateVariant(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewInventoryService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Creates Variant Under Parent", func(t *testing.T) {
+		parentID := "TSHIRT"
+		options := models.OptionValues{"size": "M", "colour": "red"}
+		variant := models.ProductStock{ProductID: "TSHIRT-M-RED", ParentID: &parentID, Options: options, Quantity: 5}
+		created := variant
+		created.Name = "T-Shirt"
+		created.SetUnitPrice(models.Money{MinorUnits: 1999, Currency: "USD"})
+		mockRepo.On("CreateVariant", ctx, variant).Return(created, nil).Once()
+
+		result, err := svc.CreateVariant(ctx, "TSHIRT", "TSHIRT-M-RED", "", options, nil, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Missing Options", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewInventoryService(mockRepo)
+
+		_, err := svc.CreateVariant(ctx, "TSHIRT", "TSHIRT-M", "", nil, nil, 5)
+
+		assert.ErrorIs(t, err, ErrInvalidVariant)
+		mockRepo.AssertNotCalled(t, "CreateVariant", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Duplicate Options", func(t *testing.T) {
+		mockRepo.On("CreateVariant", ctx, mock.Anything).Return(models.ProductStock{}, repository.ErrDuplicateVariant).Once()
+
+		_, err := svc.CreateVariant(ctx, "TSHIRT", "TSHIRT-M-RED-2", "", models.OptionValues{"size": "M"}, nil, 1)
+
+		assert.ErrorIs(t, err, repository.ErrDuplicateVariant)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInventoryService_SetBundleComponents(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Sets Components", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewInventoryService(mockRepo)
+		components := []models.BundleComponent{{ComponentID: "PROD-004", Quantity: 1}, {ComponentID: "PROD-007", Quantity: 1}}
+		bundle := models.ProductStock{ProductID: "DESK-SETUP", Quantity: 8, Components: components}
+		mockRepo.On("SetBundleComponents", ctx, "DESK-SETUP", components).Return(bundle, nil).Once()
+
+		result, err := svc.SetBundleComponents(ctx, "DESK-SETUP", components)
+
+		assert.NoError(t, err)
+		assert.Equal(t, bundle, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	invalid := map[string][]models.BundleComponent{
+		"Missing Product":   {{Quantity: 1}},
+		"Zero Quantity":     {{ComponentID: "PROD-004"}},
+		"Duplicate Product": {{ComponentID: "PROD-004", Quantity: 1}, {ComponentID: "PROD-004", Quantity: 2}},
+		"Bundle Itself":     {{ComponentID: "DESK-SETUP", Quantity: 1}},
+	}
+	for name, components := range invalid {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			svc := NewInventoryService(mockRepo)
+
+			_, err := svc.SetBundleComponents(ctx, "DESK-SETUP", components)
+
+			assert.ErrorIs(t, err, ErrInvalidBundle)
+			mockRepo.AssertNotCalled(t, "SetBundleComponents", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
 }
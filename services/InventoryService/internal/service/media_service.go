@@ -0,0 +1,182 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/storage"
+	"log/slog"
+	"strings"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	MaxImageBytes  = 10 << 20
+	maxImagePixels = 40_000_000 // Refuse to decode anything larger, whatever its file size
+
+	thumbnailSize = 320  // Longest edge of the thumbnail rendition
+	detailSize    = 1600 // Longest edge of the detail rendition
+	jpegQuality   = 85
+)
+
+var ErrInvalidImage = errors.New("image must be a JPEG, PNG, GIF or WebP file of at most 10 MB and 40 megapixels")
+
+type MediaService interface {
+	UploadImage(ctx context.Context, productID string, data []byte) (models.ProductImage, error)
+	ListImages(ctx context.Context, productID string) ([]models.ProductImage, error)
+	ReorderImages(ctx context.Context, productID string, imageIDs []int64) ([]models.ProductImage, error)
+	DeleteImage(ctx context.Context, productID string, imageID int64) error
+}
+
+type mediaService struct {
+	repo    repository.MediaRepository
+	storage storage.Storage
+}
+
+func NewMediaService(repo repository.MediaRepository, storage storage.Storage) MediaService {
+	return &mediaService{repo: repo, storage: storage}
+}
+
+// UploadImage stores a thumbnail and a detail rendition of the uploaded picture and appends
+// it to the product's images. Both are JPEGs that fit in a square of their size; smaller
+// pictures are not enlarged.
+func (s *mediaService) UploadImage(ctx context.Context, productID string, data []byte) (models.ProductImage, error) {
+	if len(data) == 0 || len(data) > MaxImageBytes {
+		return models.ProductImage{}, ErrInvalidImage
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return models.ProductImage{}, ErrInvalidImage
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return models.ProductImage{}, ErrInvalidImage
+	}
+
+	thumbnail, err := encodeJPEG(fitWithin(src, thumbnailSize))
+	if err != nil {
+		return models.ProductImage{}, err
+	}
+	detailImage := fitWithin(src, detailSize)
+	detail, err := encodeJPEG(detailImage)
+	if err != nil {
+		return models.ProductImage{}, err
+	}
+
+	prefix, err := imageKeyPrefix(productID)
+	if err != nil {
+		return models.ProductImage{}, err
+	}
+	img := models.ProductImage{
+		ProductID:    productID,
+		ThumbnailKey: prefix + "-thumb.jpg",
+		DetailKey:    prefix + "-detail.jpg",
+		Width:        int32(detailImage.Bounds().Dx()),
+		Height:       int32(detailImage.Bounds().Dy()),
+	}
+	img.ThumbnailURL = s.storage.URL(img.ThumbnailKey)
+	img.DetailURL = s.storage.URL(img.DetailKey)
+
+	if err := s.storage.Put(ctx, img.ThumbnailKey, thumbnail, "image/jpeg"); err != nil {
+		return models.ProductImage{}, err
+	}
+	if err := s.storage.Put(ctx, img.DetailKey, detail, "image/jpeg"); err != nil {
+		deleteImageFiles(ctx, s.storage, img)
+		return models.ProductImage{}, err
+	}
+	created, err := s.repo.CreateImage(ctx, img)
+	if err != nil {
+		deleteImageFiles(ctx, s.storage, img)
+		return models.ProductImage{}, err
+	}
+	slog.InfoContext(ctx, "Uploaded product image", "product_id", productID, "image_id", created.ID,
+		"width", config.Width, "height", config.Height)
+	return created, nil
+}
+
+func (s *mediaService) ListImages(ctx context.Context, productID string) ([]models.ProductImage, error) {
+	return s.repo.ListImages(ctx, productID)
+}
+
+func (s *mediaService) ReorderImages(ctx context.Context, productID string, imageIDs []int64) ([]models.ProductImage, error) {
+	slog.InfoContext(ctx, "Reordering product images", "product_id", productID, "images", len(imageIDs))
+	return s.repo.ReorderImages(ctx, productID, imageIDs)
+}
+
+// DeleteImage removes the image from the product; its files are deleted on a best-effort
+// basis once the row is gone.
+func (s *mediaService) DeleteImage(ctx context.Context, productID string, imageID int64) error {
+	img, err := s.repo.DeleteImage(ctx, productID, imageID)
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "Deleted product image", "product_id", productID, "image_id", imageID)
+	deleteImageFiles(ctx, s.storage, img)
+	return nil
+}
+
+// deleteImageFiles deletes both renditions of an image, logging rather than returning
+// failures since the image is already gone from the catalog.
+func deleteImageFiles(ctx context.Context, store storage.Storage, img models.ProductImage) {
+	for _, key := range []string{img.ThumbnailKey, img.DetailKey} {
+		if err := store.Delete(ctx, key); err != nil {
+			slog.WarnContext(ctx, "Failed to delete image file", "key", key, "error", err)
+		}
+	}
+}
+
+// fitWithin scales src down, keeping its aspect ratio, until its longest edge is at most size
+// pixels, and flattens it onto white since JPEG has no transparency.
+func fitWithin(src image.Image, size int) image.Image {
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+	return dst
+}
+
+func encodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// imageKeyPrefix names a new image's files after the product, reduced to characters that are
+// safe in both storage keys and URLs, plus a random part so uploads never collide.
+func imageKeyPrefix(productID string) (string, error) {
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	safe := strings.Map(func(r rune) rune {
+		switch {
+		case 'A' <= r && r <= 'Z', 'a' <= r && r <= 'z', '0' <= r && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, productID)
+	return fmt.Sprintf("products/%s/%s", safe, hex.EncodeToString(random)), nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMediaRepository is a mock of the MediaRepository interface
+type MockMediaRepository struct {
+	mock.Mock
+}
+
+func (m *MockMediaRepository) CreateImage(ctx context.Context, image models.ProductImage) (models.ProductImage, error) {
+	args := m.Called(ctx, image)
+	return args.Get(0).(models.ProductImage), args.Error(1)
+}
+
+func (m *MockMediaRepository) ListImages(ctx context.Context, productID string) ([]models.ProductImage, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).([]models.ProductImage), args.Error(1)
+}
+
+func (m *MockMediaRepository) ReorderImages(ctx context.Context, productID string, imageIDs []int64) ([]models.ProductImage, error) {
+	args := m.Called(ctx, productID, imageIDs)
+	return args.Get(0).([]models.ProductImage), args.Error(1)
+}
+
+func (m *MockMediaRepository) DeleteImage(ctx context.Context, productID string, imageID int64) (models.ProductImage, error) {
+	args := m.Called(ctx, productID, imageID)
+	return args.Get(0).(models.ProductImage), args.Error(1)
+}
+
+func testPNG(width int, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	png.Encode(&buf, img)
+	return buf.Bytes()
+}
+
+func TestMediaService_UploadImage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Stores thumbnail and detail renditions", func(t *testing.T) {
+		dir := t.TempDir()
+		mockRepo := new(MockMediaRepository)
+		svc := NewMediaService(mockRepo, storage.NewLocalStorage(dir, "/media"))
+
+		mockRepo.On("CreateImage", ctx, mock.MatchedBy(func(img models.ProductImage) bool {
+			return img.ProductID == "PROD/001" && img.Width == 1600 && img.Height == 800
+		})).Return(models.ProductImage{ID: 7}, nil).Once()
+
+		_, err := svc.UploadImage(ctx, "PROD/001", testPNG(2000, 1000))
+
+		assert.NoError(t, err)
+		img := mockRepo.Calls[0].Arguments.Get(1).(models.ProductImage)
+		assert.Regexp(t, `^products/PROD_001/[0-9a-f]{16}-thumb\.jpg$`, img.ThumbnailKey)
+		assert.Equal(t, "/media/"+img.DetailKey, img.DetailURL)
+
+		thumbnail, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(img.ThumbnailKey)))
+		assert.NoError(t, err)
+		config, err := jpeg.DecodeConfig(bytes.NewReader(thumbnail))
+		assert.NoError(t, err)
+		assert.Equal(t, 320, config.Width)
+		assert.Equal(t, 160, config.Height)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Does not enlarge small pictures", func(t *testing.T) {
+		mockRepo := new(MockMediaRepository)
+		svc := NewMediaService(mockRepo, storage.NewLocalStorage(t.TempDir(), "/media"))
+
+		mockRepo.On("CreateImage", ctx, mock.MatchedBy(func(img models.ProductImage) bool {
+			return img.Width == 100 && img.Height == 200
+		})).Return(models.ProductImage{ID: 8}, nil).Once()
+
+		_, err := svc.UploadImage(ctx, "PROD-001", testPNG(100, 200))
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rejects files that are not images", func(t *testing.T) {
+		mockRepo := new(MockMediaRepository)
+		svc := NewMediaService(mockRepo, storage.NewLocalStorage(t.TempDir(), "/media"))
+
+		_, err := svc.UploadImage(ctx, "PROD-001", []byte("%PDF-1.7"))
+
+		assert.ErrorIs(t, err, ErrInvalidImage)
+		mockRepo.AssertNotCalled(t, "CreateImage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Removes the files when the product is missing", func(t *testing.T) {
+		dir := t.TempDir()
+		mockRepo := new(MockMediaRepository)
+		svc := NewMediaService(mockRepo, storage.NewLocalStorage(dir, "/media"))
+
+		mockRepo.On("CreateImage", ctx, mock.Anything).Return(models.ProductImage{}, repository.ErrProductNotFound).Once()
+
+		_, err := svc.UploadImage(ctx, "PROD-404", testPNG(10, 10))
+
+		assert.True(t, errors.Is(err, repository.ErrProductNotFound))
+		entries, _ := os.ReadDir(filepath.Join(dir, "products", "PROD-404"))
+		assert.Empty(t, entries)
+	})
+}
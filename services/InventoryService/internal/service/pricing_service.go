@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPriceList     = errors.New("price list needs an id, a name, an ISO 4217 currency and a valid rounding rule")
+	ErrInvalidExchangeRate  = errors.New("exchange rate needs two different ISO 4217 currencies and a positive decimal rate")
+	ErrPriceListCurrency    = errors.New("price list is not in the requested currency")
+	ErrInvalidCurrency      = errors.New("currency must be an ISO 4217 code")
+	ErrNoExchangeRate       = errors.New("no exchange rate")
+	ErrInvalidPriceSchedule = errors.New("price schedule needs a non-negative price and an end after its start that is still to come")
+)
+
+// maxExchangeRate keeps rates inside the numeric(20,10) column.
+var maxExchangeRate = big.NewRat(10_000_000_000, 1)
+
+type PricingService interface {
+	CreatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error)
+	UpdatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error)
+	DeletePriceList(ctx context.Context, priceListID string) error
+	GetPriceList(ctx context.Context, priceListID string) (models.PriceList, error)
+	ListPriceLists(ctx context.Context) ([]models.PriceList, error)
+	SetPrice(ctx context.Context, priceListID string, productID string, minorUnits int64) (models.PriceListEntry, error)
+	DeletePrice(ctx context.Context, priceListID string, productID string) error
+	SetExchangeRate(ctx context.Context, baseCurrency string, quoteCurrency string, rate string) (models.ExchangeRate, error)
+	DeleteExchangeRate(ctx context.Context, baseCurrency string, quoteCurrency string) error
+	ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error)
+	PriceProducts(ctx context.Context, selector models.PriceSelector, products []models.ProductStock) ([]models.ProductStock, error)
+	CreatePriceSchedule(ctx context.Context, schedule models.PriceSchedule) (models.PriceSchedule, error)
+	CancelPriceSchedule(ctx context.Context, productID string, scheduleID int64) error
+	ListPriceSchedules(ctx context.Context, productID string, includeEnded bool) ([]models.PriceSchedule, error)
+}
+
+type pricingService struct {
+	repo repository.PricingRepository
+}
+
+func NewPricingService(repo repository.PricingRepository) PricingService {
+	return &pricingService{repo: repo}
+}
+
+func (s *pricingService) CreatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error) {
+	list, err := newPriceList(list)
+	if err != nil {
+		return models.PriceList{}, err
+	}
+	slog.InfoContext(ctx, "Creating price list", "price_list_id", list.ID, "currency", list.Currency)
+	return s.repo.CreatePriceList(ctx, list)
+}
+
+// UpdatePriceList renames a price list and changes its rounding; its currency cannot change.
+func (s *pricingService) UpdatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error) {
+	existing, err := s.repo.GetPriceList(ctx, strings.TrimSpace(list.ID))
+	if err != nil {
+		return models.PriceList{}, err
+	}
+	list.Currency = existing.Currency
+	list, err = newPriceList(list)
+	if err != nil {
+		return models.PriceList{}, err
+	}
+	slog.InfoContext(ctx, "Updating price list", "price_list_id", list.ID)
+	return s.repo.UpdatePriceList(ctx, list)
+}
+
+func (s *pricingService) DeletePriceList(ctx context.Context, priceListID string) error {
+	slog.InfoContext(ctx, "Deleting price list", "price_list_id", priceListID)
+	return s.repo.DeletePriceList(ctx, priceListID)
+}
+
+func (s *pricingService) GetPriceList(ctx context.Context, priceListID string) (models.PriceList, error) {
+	return s.repo.GetPriceList(ctx, priceListID)
+}
+
+func (s *pricingService) ListPriceLists(ctx context.Context) ([]models.PriceList, error) {
+	return s.repo.ListPriceLists(ctx)
+}
+
+// SetPrice gives a product an explicit price in a price list, in minor units of its currency.
+func (s *pricingService) SetPrice(ctx context.Context, priceListID string, productID string, minorUnits int64) (models.PriceListEntry, error) {
+	if minorUnits < 0 {
+		return models.PriceListEntry{}, ErrInvalidPrice
+	}
+	slog.InfoContext(ctx, "Setting list price", "price_list_id", priceListID, "product_id", productID, "minor_units", minorUnits)
+	return s.repo.SetPrice(ctx, models.PriceListEntry{PriceListID: priceListID, ProductID: productID, MinorUnits: minorUnits})
+}
+
+// DeletePrice drops a product's explicit price, so the list converts its catalog price again.
+func (s *pricingService) DeletePrice(ctx context.Context, priceListID string, productID string) error {
+	slog.InfoContext(ctx, "Deleting list price", "price_list_id", priceListID, "product_id", productID)
+	return s.repo.DeletePrice(ctx, priceListID, productID)
+}
+
+// SetExchangeRate stores how many units of quoteCurrency one unit of baseCurrency buys. The
+// rate is a decimal string such as "0.9215" and is kept exactly.
+func (s *pricingService) SetExchangeRate(ctx context.Context, baseCurrency string, quoteCurrency string, rate string) (models.ExchangeRate, error) {
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || value.Sign() <= 0 || value.Cmp(maxExchangeRate) >= 0 ||
+		!models.ValidCurrency(baseCurrency) || !models.ValidCurrency(quoteCurrency) || baseCurrency == quoteCurrency {
+		return models.ExchangeRate{}, ErrInvalidExchangeRate
+	}
+	slog.InfoContext(ctx, "Setting exchange rate", "base_currency", baseCurrency, "quote_currency", quoteCurrency, "rate", rate)
+	return s.repo.SetExchangeRate(ctx, models.ExchangeRate{BaseCurrency: baseCurrency, QuoteCurrency: quoteCurrency, Rate: value.FloatString(10)})
+}
+
+func (s *pricingService) DeleteExchangeRate(ctx context.Context, baseCurrency string, quoteCurrency string) error {
+	slog.InfoContext(ctx, "Deleting exchange rate", "base_currency", baseCurrency, "quote_currency", quoteCurrency)
+	return s.repo.DeleteExchangeRate(ctx, baseCurrency, quoteCurrency)
+}
+
+func (s *pricingService) ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	return s.repo.ListExchangeRates(ctx)
+}
+
+// CreatePriceSchedule queues a price for a product from schedule.StartsAt until
+// schedule.EndsAt. An empty currency means the product's own.
+func (s *pricingService) CreatePriceSchedule(ctx context.Context, schedule models.PriceSchedule) (models.PriceSchedule, error) {
+	switch {
+	case schedule.UnitPrice.MinorUnits < 0,
+		schedule.UnitPrice.Currency != "" && !models.ValidCurrency(schedule.UnitPrice.Currency),
+		!schedule.EndsAt.After(schedule.StartsAt),
+		!schedule.EndsAt.After(time.Now()):
+		return models.PriceSchedule{}, ErrInvalidPriceSchedule
+	}
+	schedule.ID = 0
+	slog.InfoContext(ctx, "Scheduling price", "product_id", schedule.ProductID, "minor_units", schedule.UnitPrice.MinorUnits,
+		"starts_at", schedule.StartsAt, "ends_at", schedule.EndsAt)
+	return s.repo.CreatePriceSchedule(ctx, schedule)
+}
+
+func (s *pricingService) CancelPriceSchedule(ctx context.Context, productID string, scheduleID int64) error {
+	slog.InfoContext(ctx, "Cancelling price schedule", "product_id", productID, "schedule_id", scheduleID)
+	return s.repo.CancelPriceSchedule(ctx, productID, scheduleID)
+}
+
+// ListPriceSchedules returns a product's running and upcoming schedules, and with
+// includeEnded its past ones too.
+func (s *pricingService) ListPriceSchedules(ctx context.Context, productID string, includeEnded bool) ([]models.PriceSchedule, error) {
+	return s.repo.ListPriceSchedules(ctx, productID, includeEnded, time.Now())
+}
+
+// PriceProducts returns copies of products, variants included, priced as selector asks. A
+// product sells at its explicit list price when it has one; a variant without an override
+// also takes its parent's. Everything else is converted from its catalog price and rounded
+// by the list's rule. Converted variants lose their override, which is in the catalog currency.
+func (s *pricingService) PriceProducts(ctx context.Context, selector models.PriceSelector, products []models.ProductStock) ([]models.ProductStock, error) {
+	if selector.PriceListID == "" && selector.Currency == "" {
+		return products, nil
+	}
+	list, err := s.resolvePriceList(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := map[string]int64{}
+	if list.ID != "" {
+		prices, err = s.repo.GetPrices(ctx, list.ID, pricedProductIDs(products))
+		if err != nil {
+			return nil, err
+		}
+	}
+	rates, err := s.repo.GetExchangeRates(ctx, list.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return priceProducts(list, prices, rates, products)
+}
+
+// resolvePriceList finds the list a selector names. A currency without any price list gets
+// an unsaved one that only converts, rounding to the nearest minor unit.
+func (s *pricingService) resolvePriceList(ctx context.Context, selector models.PriceSelector) (models.PriceList, error) {
+	if selector.PriceListID != "" {
+		list, err := s.repo.GetPriceList(ctx, selector.PriceListID)
+		if err != nil {
+			return models.PriceList{}, err
+		}
+		if selector.Currency != "" && selector.Currency != list.Currency {
+			return models.PriceList{}, fmt.Errorf("%w: %s is priced in %s", ErrPriceListCurrency, list.ID, list.Currency)
+		}
+		return list, nil
+	}
+	if !models.ValidCurrency(selector.Currency) {
+		return models.PriceList{}, ErrInvalidCurrency
+	}
+	list, err := s.repo.FindPriceList(ctx, selector.Currency)
+	if errors.Is(err, repository.ErrPriceListNotFound) {
+		return models.PriceList{Currency: selector.Currency, Rounding: models.RoundHalfUp, RoundingIncrement: 1}, nil
+	}
+	return list, err
+}
+
+func priceProducts(list models.PriceList, prices map[string]int64, rates []models.ExchangeRate, products []models.ProductStock) ([]models.ProductStock, error) {
+	if products == nil {
+		return nil, nil
+	}
+	priced := make([]models.ProductStock, len(products))
+	for i, product := range products {
+		minorUnits, ok := prices[product.ProductID]
+		if !ok && product.ParentID != nil && product.UnitPriceOverride == nil {
+			minorUnits, ok = prices[*product.ParentID]
+		}
+		price := models.Money{MinorUnits: minorUnits, Currency: list.Currency}
+		if !ok {
+			rate, err := exchangeRate(rates, product.UnitPrice.Currency, list.Currency)
+			if err != nil {
+				return nil, fmt.Errorf("pricing %s: %w", product.ProductID, err)
+			}
+			price = convertPrice(product.UnitPrice, rate, list)
+		}
+
+		variants, err := priceProducts(list, prices, rates, product.Variants)
+		if err != nil {
+			return nil, err
+		}
+		product.Variants = variants
+		product.SetUnitPrice(price)
+		product.SetUnitPriceOverride(nil)
+		product.PriceListID = list.ID
+		// The list price or conversion replaces any scheduled price
+		product.PriceScheduleID = nil
+		priced[i] = product
+	}
+	return priced, nil
+}
+
+// pricedProductIDs lists the products, their variants and their parents, which are all the
+// rows whose explicit list prices can apply.
+func pricedProductIDs(products []models.ProductStock) []string {
+	var ids []string
+	for _, product := range products {
+		ids = append(ids, product.ProductID)
+		if product.ParentID != nil {
+			ids = append(ids, *product.ParentID)
+		}
+		ids = append(ids, pricedProductIDs(product.Variants)...)
+	}
+	return ids
+}
+
+// exchangeRate finds the rate from one currency to another, falling back to the inverse of
+// the opposite pair.
+func exchangeRate(rates []models.ExchangeRate, from string, to string) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+	var inverse *big.Rat
+	for _, rate := range rates {
+		value, ok := new(big.Rat).SetString(rate.Rate)
+		if !ok || value.Sign() <= 0 {
+			continue
+		}
+		if rate.BaseCurrency == from && rate.QuoteCurrency == to {
+			return value, nil
+		}
+		if rate.BaseCurrency == to && rate.QuoteCurrency == from {
+			inverse = value.Inv(value)
+		}
+	}
+	if inverse != nil {
+		return inverse, nil
+	}
+	return nil, fmt.Errorf("%w from %s to %s", ErrNoExchangeRate, from, to)
+}
+
+// convertPrice converts price at rate into the list's currency, exactly, and only then rounds
+// it by the list's rule.
+func convertPrice(price models.Money, rate *big.Rat, list models.PriceList) models.Money {
+	amount := new(big.Rat).Mul(new(big.Rat).SetInt64(price.MinorUnits), rate)
+	// Rescale between the minor units of both currencies, e.g. cents to yen
+	shift := models.CurrencyExponent(list.Currency) - models.CurrencyExponent(price.Currency)
+	for ; shift > 0; shift-- {
+		amount.Mul(amount, big.NewRat(10, 1))
+	}
+	for ; shift < 0; shift++ {
+		amount.Quo(amount, big.NewRat(10, 1))
+	}
+	return models.Money{MinorUnits: roundMinorUnits(amount, list.Rounding, list.RoundingIncrement), Currency: list.Currency}
+}
+
+// roundMinorUnits rounds a non-negative amount of minor units to a multiple of increment.
+func roundMinorUnits(amount *big.Rat, mode models.RoundingMode, increment int64) int64 {
+	if increment < 1 {
+		increment = 1
+	}
+	steps := new(big.Rat).Quo(amount, big.NewRat(increment, 1))
+	quotient, remainder := new(big.Int).DivMod(steps.Num(), steps.Denom(), new(big.Int))
+	switch mode {
+	case models.RoundUp:
+		if remainder.Sign() > 0 {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	case models.RoundDown:
+	default:
+		if new(big.Int).Lsh(remainder, 1).Cmp(steps.Denom()) >= 0 {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient.Int64() * increment
+}
+
+func newPriceList(list models.PriceList) (models.PriceList, error) {
+	list.ID = strings.TrimSpace(list.ID)
+	list.Name = strings.TrimSpace(list.Name)
+	if list.Rounding == "" {
+		list.Rounding = models.RoundHalfUp
+	}
+	if list.RoundingIncrement == 0 {
+		list.RoundingIncrement = 1
+	}
+	switch {
+	case list.ID == "", list.Name == "", !models.ValidCurrency(list.Currency), list.RoundingIncrement < 0:
+		return list, ErrInvalidPriceList
+	case list.Rounding != models.RoundHalfUp && list.Rounding != models.RoundUp && list.Rounding != models.RoundDown:
+		return list, ErrInvalidPriceList
+	}
+	return list, nil
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPricingRepository is a mock of the PricingRepository interface
+type MockPricingRepository struct {
+	mock.Mock
+}
+
+func (m *MockPricingRepository) CreatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error) {
+	args := m.Called(ctx, list)
+	return args.Get(0).(models.PriceList), args.Error(1)
+}
+
+func (m *MockPricingRepository) UpdatePriceList(ctx context.Context, list models.PriceList) (models.PriceList, error) {
+	args := m.Called(ctx, list)
+	return args.Get(0).(models.PriceList), args.Error(1)
+}
+
+func (m *MockPricingRepository) DeletePriceList(ctx context.Context, priceListID string) error {
+	args := m.Called(ctx, priceListID)
+	return args.Error(0)
+}
+
+func (m *MockPricingRepository) GetPriceList(ctx context.Context, priceListID string) (models.PriceList, error) {
+	args := m.Called(ctx, priceListID)
+	return args.Get(0).(models.PriceList), args.Error(1)
+}
+
+func (m *MockPricingRepository) FindPriceList(ctx context.Context, currency string) (models.PriceList, error) {
+	args := m.Called(ctx, currency)
+	return args.Get(0).(models.PriceList), args.Error(1)
+}
+
+func (m *MockPricingRepository) ListPriceLists(ctx context.Context) ([]models.PriceList, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.PriceList), args.Error(1)
+}
+
+func (m *MockPricingRepository) SetPrice(ctx context.Context, entry models.PriceListEntry) (models.PriceListEntry, error) {
+	args := m.Called(ctx, entry)
+	return args.Get(0).(models.PriceListEntry), args.Error(1)
+}
+
+func (m *MockPricingRepository) DeletePrice(ctx context.Context, priceListID string, productID string) error {
+	args := m.Called(ctx, priceListID, productID)
+	return args.Error(0)
+}
+
+func (m *MockPricingRepository) GetPrices(ctx context.Context, priceListID string, productIDs []string) (map[string]int64, error) {
+	args := m.Called(ctx, priceListID, productIDs)
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+func (m *MockPricingRepository) SetExchangeRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error) {
+	args := m.Called(ctx, rate)
+	return args.Get(0).(models.ExchangeRate), args.Error(1)
+}
+
+func (m *MockPricingRepository) DeleteExchangeRate(ctx context.Context, baseCurrency string, quoteCurrency string) error {
+	args := m.Called(ctx, baseCurrency, quoteCurrency)
+	return args.Error(0)
+}
+
+func (m *MockPricingRepository) ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.ExchangeRate), args.Error(1)
+}
+
+func (m *MockPricingRepository) GetExchangeRates(ctx context.Context, currency string) ([]models.ExchangeRate, error) {
+	args := m.Called(ctx, currency)
+	return args.Get(0).([]models.ExchangeRate), args.Error(1)
+}
+
+func (m *MockPricingRepository) CreatePriceSchedule(ctx context.Context, schedule models.PriceSchedule) (models.PriceSchedule, error) {
+	args := m.Called(ctx, schedule)
+	return args.Get(0).(models.PriceSchedule), args.Error(1)
+}
+
+func (m *MockPricingRepository) CancelPriceSchedule(ctx context.Context, productID string, scheduleID int64) error {
+	args := m.Called(ctx, productID, scheduleID)
+	return args.Error(0)
+}
+
+func (m *MockPricingRepository) ListPriceSchedules(ctx context.Context, productID string, includeEnded bool, at time.Time) ([]models.PriceSchedule, error) {
+	args := m.Called(ctx, productID, includeEnded, at)
+	return args.Get(0).([]models.PriceSchedule), args.Error(1)
+}
+
+func usd(minorUnits int64) models.Money {
+	return models.Money{MinorUnits: minorUnits, Currency: "USD"}
+}
+
+func TestPricingService_PriceProducts(t *testing.T) {
+	ctx := context.Background()
+	eur := models.PriceList{ID: "eu-retail", Name: "EU retail", Currency: "EUR", Rounding: models.RoundUp, RoundingIncrement: 5}
+	rates := []models.ExchangeRate{{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: "0.9215000000"}}
+
+	t.Run("Converts And Rounds By The List Rule", func(t *testing.T) {
+		mockRepo := new(MockPricingRepository)
+		svc := NewPricingService(mockRepo)
+		mockRepo.On("GetPriceList", ctx, "eu-retail").Return(eur, nil).Once()
+		mockRepo.On("GetPrices", ctx, "eu-retail", []string{"PROD-001"}).Return(map[string]int64{}, nil).Once()
+		mockRepo.On("GetExchangeRates", ctx, "EUR").Return(rates, nil).Once()
+		product := models.ProductStock{ProductID: "PROD-001", UnitPrice: usd(129999)}
+
+		priced, err := svc.PriceProducts(ctx, models.PriceSelector{PriceListID: "eu-retail"}, []models.ProductStock{product})
+
+		// 129999 * 0.9215 = 119794.0785 cents, rounded up to a multiple of 5
+		assert.NoError(t, err)
+		assert.Equal(t, models.Money{MinorUnits: 119795, Currency: "EUR"}, priced[0].UnitPrice)
+		assert.Equal(t, 1197.95, priced[0].Price)
+		assert.Equal(t, "eu-retail", priced[0].PriceListID)
+		assert.Equal(t, usd(129999), product.UnitPrice, "the caller's product is left alone")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Explicit Prices Win And Variants Inherit Them", func(t *testing.T) {
+		mockRepo := new(MockPricingRepository)
+		svc := NewPricingService(mockRepo)
+		parentID := "TSHIRT"
+		override := int64(2999)
+		parent := models.ProductStock{ProductID: "TSHIRT", UnitPrice: usd(1999), Variants: []models.ProductStock{
+			{ProductID: "TSHIRT-M", ParentID: &parentID, UnitPrice: usd(1999)},
+			{ProductID: "TSHIRT-XXL", ParentID: &parentID, UnitPrice: usd(2999), UnitPriceOverride: &override},
+		}}
+		mockRepo.On("FindPriceList", ctx, "EUR").Return(eur, nil).Once()
+		mockRepo.On("GetPrices", ctx, "eu-retail", mock.Anything).Return(map[string]int64{"TSHIRT": 1800}, nil).Once()
+		mockRepo.On("GetExchangeRates", ctx, "EUR").Return(rates, nil).Once()
+
+		priced, err := svc.PriceProducts(ctx, models.PriceSelector{Currency: "EUR"}, []models.ProductStock{parent})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1800), priced[0].UnitPrice.MinorUnits)
+		assert.Equal(t, int64(1800), priced[0].Variants[0].UnitPrice.MinorUnits)
+		// 2999 * 0.9215 = 2763.5785, rounded up to 2765; the USD override is dropped
+		assert.Equal(t, int64(2765), priced[0].Variants[1].UnitPrice.MinorUnits)
+		assert.Nil(t, priced[0].Variants[1].UnitPriceOverride)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("List Price Replaces A Scheduled Price", func(t *testing.T) {
+		mockRepo := new(MockPricingRepository)
+		svc := NewPricingService(mockRepo)
+		scheduleID := int64(7)
+		product := models.ProductStock{ProductID: "PROD-001", UnitPrice: usd(99999), PriceScheduleID: &scheduleID}
+		mockRepo.On("GetPriceList", ctx, "eu-retail").Return(eur, nil).Once()
+		mockRepo.On("GetPrices", ctx, "eu-retail", []string{"PROD-001"}).Return(map[string]int64{"PROD-001": 110000}, nil).Once()
+		mockRepo.On("GetExchangeRates", ctx, "EUR").Return(rates, nil).Once()
+
+		priced, err := svc.PriceProducts(ctx, models.PriceSelector{PriceListID: "eu-retail"}, []models.ProductStock{product})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.Money{MinorUnits: 110000, Currency: "EUR"}, priced[0].UnitPrice)
+		assert.Equal(t, "eu-retail", priced[0].PriceListID)
+		assert.Nil(t, priced[0].PriceScheduleID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Currency Without A List Uses The Inverse Rate", func(t *testing.T) {
+		mockRepo := new(MockPricingRepository)
+		svc := NewPricingService(mockRepo)
+		mockRepo.On("FindPriceList", ctx, "GBP").Return(models.PriceList{}, repository.ErrPriceListNotFound).Once()
+		mockRepo.On("GetExchangeRates", ctx, "GBP").Return([]models.ExchangeRate{{BaseCurrency: "GBP", QuoteCurrency: "USD", Rate: "1.25"}}, nil).Once()
+
+		priced, err := svc.PriceProducts(ctx, models.PriceSelector{Currency: "GBP"}, []models.ProductStock{{ProductID: "PROD-005", UnitPrice: usd(1299)}})
+
+		// 1299 / 1.25 = 1039.2 pence, to the nearest penny
+		assert.NoError(t, err)
+		assert.Equal(t, models.Money{MinorUnits: 1039, Currency: "GBP"}, priced[0].UnitPrice)
+		assert.Empty(t, priced[0].PriceListID)
+		mockRepo.AssertNotCalled(t, "GetPrices", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Missing Exchange Rate", func(t *testing.T) {
+		mockRepo := new(MockPricingRepository)
+		svc := NewPricingService(mockRepo)
+		mockRepo.On("FindPriceList", ctx, "JPY").Return(models.PriceList{}, repository.ErrPriceListNotFound).Once()
+		mockRepo.On("GetExchangeRates", ctx, "JPY").Return([]models.ExchangeRate{}, nil).Once()
+
+		_, err := svc.PriceProducts(ctx, models.PriceSelector{Currency: "JPY"}, []models.ProductStock{{ProductID: "PROD-005", UnitPrice: usd(1299)}})
+
+		assert.ErrorIs(t, err, ErrNoExchangeRate)
+	})
+
+	t.Run("List In Another Currency", func(t *testing.T) {
+		mockRepo := new(MockPricingRepository)
+		svc := NewPricingService(mockRepo)
+		mockRepo.On("GetPriceList", ctx, "eu-retail").Return(eur, nil).Once()
+
+		_, err := svc.PriceProducts(ctx, models.PriceSelector{PriceListID: "eu-retail", Currency: "GBP"}, nil)
+
+		assert.ErrorIs(t, err, ErrPriceListCurrency)
+	})
+
+	t.Run("No Selector Keeps Catalog Prices", func(t *testing.T) {
+		mockRepo := new(MockPricingRepository)
+		svc := NewPricingService(mockRepo)
+		products := []models.ProductStock{{ProductID: "PROD-005", UnitPrice: usd(1299)}}
+
+		priced, err := svc.PriceProducts(ctx, models.PriceSelector{}, products)
+
+		assert.NoError(t, err)
+		assert.Equal(t, products, priced)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestConvertPrice(t *testing.T) {
+	list := models.PriceList{Currency: "JPY", Rounding: models.RoundHalfUp, RoundingIncrement: 10}
+
+	// 1299 cents at 151.5 yen per dollar is 1967.985 yen, to the nearest 10
+	price, err := exchangeRate([]models.ExchangeRate{{BaseCurrency: "USD", QuoteCurrency: "JPY", Rate: "151.5"}}, "USD", "JPY")
+	assert.NoError(t, err)
+	assert.Equal(t, models.Money{MinorUnits: 1970, Currency: "JPY"}, convertPrice(usd(1299), price, list))
+
+	list.Rounding = models.RoundDown
+	assert.Equal(t, models.Money{MinorUnits: 1960, Currency: "JPY"}, convertPrice(usd(1299), price, list))
+}
+
+func TestPricingService_SetExchangeRate(t *testing.T) {
+	mockRepo := new(MockPricingRepository)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Stores The Rate Exactly", func(t *testing.T) {
+		rate := models.ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: "0.9215000000"}
+		mockRepo.On("SetExchangeRate", ctx, rate).Return(rate, nil).Once()
+
+		stored, err := svc.SetExchangeRate(ctx, "USD", "EUR", " 0.9215 ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, rate, stored)
+		mockRepo.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct{ name, base, quote, rate string }{
+		{"Zero Rate", "USD", "EUR", "0"},
+		{"Not A Number", "USD", "EUR", "abc"},
+		{"Same Currency", "USD", "USD", "1"},
+		{"Lower Case Currency", "usd", "EUR", "0.9"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.SetExchangeRate(ctx, tc.base, tc.quote, tc.rate)
+
+			assert.ErrorIs(t, err, ErrInvalidExchangeRate)
+		})
+	}
+}
+
+func TestPricingService_CreatePriceList(t *testing.T) {
+	mockRepo := new(MockPricingRepository)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Defaults The Rounding Rule", func(t *testing.T) {
+		list := models.PriceList{ID: "uk", Name: "UK", Currency: "GBP", Rounding: models.RoundHalfUp, RoundingIncrement: 1}
+		mockRepo.On("CreatePriceList", ctx, list).Return(list, nil).Once()
+
+		created, err := svc.CreatePriceList(ctx, models.PriceList{ID: " uk ", Name: "UK", Currency: "GBP"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, list, created)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Rounding Rule", func(t *testing.T) {
+		_, err := svc.CreatePriceList(ctx, models.PriceList{ID: "uk", Name: "UK", Currency: "GBP", Rounding: "BANKERS"})
+
+		assert.ErrorIs(t, err, ErrInvalidPriceList)
+	})
+}
+
+func TestPricingService_CreatePriceSchedule(t *testing.T) {
+	mockRepo := new(MockPricingRepository)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+	friday := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	monday := friday.Add(72 * time.Hour)
+
+	t.Run("Queues A Sale Window", func(t *testing.T) {
+		schedule := models.PriceSchedule{ProductID: "PROD-001", UnitPrice: models.Money{MinorUnits: 99999}, StartsAt: friday, EndsAt: monday}
+		mockRepo.On("CreatePriceSchedule", ctx, schedule).Return(schedule, nil).Once()
+
+		_, err := svc.CreatePriceSchedule(ctx, schedule)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name     string
+		schedule models.PriceSchedule
+	}{
+		{"Ends Before It Starts", models.PriceSchedule{ProductID: "PROD-001", UnitPrice: usd(99999), StartsAt: monday, EndsAt: friday}},
+		{"Already Over", models.PriceSchedule{ProductID: "PROD-001", UnitPrice: usd(99999), StartsAt: friday.AddDate(0, 0, -30), EndsAt: monday.AddDate(0, 0, -30)}},
+		{"Negative Price", models.PriceSchedule{ProductID: "PROD-001", UnitPrice: usd(-1), StartsAt: friday, EndsAt: monday}},
+		{"Bad Currency", models.PriceSchedule{ProductID: "PROD-001", UnitPrice: models.Money{MinorUnits: 1, Currency: "usd"}, StartsAt: friday, EndsAt: monday}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.CreatePriceSchedule(ctx, tc.schedule)
+
+			assert.ErrorIs(t, err, ErrInvalidPriceSchedule)
+		})
+	}
+}
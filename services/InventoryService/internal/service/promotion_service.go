@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+var ErrInvalidPromotion = errors.New("promotion needs an id, a name, a valid rule, a valid discount and a validity window that ends after it starts")
+
+type PromotionService interface {
+	CreatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error)
+	UpdatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error)
+	DeletePromotion(ctx context.Context, promotionID string) error
+	GetPromotion(ctx context.Context, promotionID string) (models.Promotion, error)
+	ListPromotions(ctx context.Context) ([]models.Promotion, error)
+	ListOffers(ctx context.Context) ([]models.Offer, error)
+}
+
+type promotionService struct {
+	repo repository.PromotionRepository
+	now  func() time.Time
+}
+
+func NewPromotionService(repo repository.PromotionRepository) PromotionService {
+	return &promotionService{repo: repo, now: time.Now}
+}
+
+func (s *promotionService) CreatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error) {
+	promotion, err := newPromotion(promotion)
+	if err != nil {
+		return models.Promotion{}, err
+	}
+	slog.InfoContext(ctx, "Creating promotion", "promotion_id", promotion.ID, "priority", promotion.Priority)
+	return s.repo.CreatePromotion(ctx, promotion)
+}
+
+func (s *promotionService) UpdatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error) {
+	promotion, err := newPromotion(promotion)
+	if err != nil {
+		return models.Promotion{}, err
+	}
+	slog.InfoContext(ctx, "Updating promotion", "promotion_id", promotion.ID, "priority", promotion.Priority)
+	return s.repo.UpdatePromotion(ctx, promotion)
+}
+
+func (s *promotionService) DeletePromotion(ctx context.Context, promotionID string) error {
+	slog.InfoContext(ctx, "Deleting promotion", "promotion_id", promotionID)
+	return s.repo.DeletePromotion(ctx, promotionID)
+}
+
+func (s *promotionService) GetPromotion(ctx context.Context, promotionID string) (models.Promotion, error) {
+	return s.repo.GetPromotion(ctx, promotionID)
+}
+
+func (s *promotionService) ListPromotions(ctx context.Context) ([]models.Promotion, error) {
+	return s.repo.ListPromotions(ctx)
+}
+
+// ListOffers returns the products the currently active promotions discount.
+func (s *promotionService) ListOffers(ctx context.Context) ([]models.Offer, error) {
+	slog.InfoContext(ctx, "Listing product offers")
+	return s.repo.ListOffers(ctx, s.now())
+}
+
+func newPromotion(promotion models.Promotion) (models.Promotion, error) {
+	promotion.ID = strings.TrimSpace(promotion.ID)
+	promotion.Name = strings.TrimSpace(promotion.Name)
+	if promotion.Rule.Match == "" {
+		promotion.Rule.Match = models.MatchAll
+	}
+	if promotion.Rule.Conditions == nil {
+		promotion.Rule.Conditions = []models.PromotionCondition{}
+	}
+	if promotion.DiscountType == models.DiscountPercent {
+		promotion.DiscountCurrency = ""
+	}
+
+	switch {
+	case promotion.ID == "", promotion.Name == "":
+		return promotion, ErrInvalidPromotion
+	case promotion.Rule.Match != models.MatchAll && promotion.Rule.Match != models.MatchAny:
+		return promotion, ErrInvalidPromotion
+	case promotion.StartsAt != nil && promotion.EndsAt != nil && !promotion.EndsAt.After(*promotion.StartsAt):
+		return promotion, ErrInvalidPromotion
+	}
+	for _, condition := range promotion.Rule.Conditions {
+		if !condition.Valid() {
+			return promotion, ErrInvalidPromotion
+		}
+	}
+	switch promotion.DiscountType {
+	case models.DiscountPercent:
+		if promotion.DiscountValue < 0 || promotion.DiscountValue > 100 {
+			return promotion, ErrInvalidPromotion
+		}
+	case models.DiscountFixed:
+		if promotion.DiscountValue < 0 || !models.ValidCurrency(promotion.DiscountCurrency) {
+			return promotion, ErrInvalidPromotion
+		}
+	default:
+		return promotion, ErrInvalidPromotion
+	}
+	return promotion, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPromotionRepository is a mock of the PromotionRepository interface
+type MockPromotionRepository struct {
+	mock.Mock
+}
+
+func (m *MockPromotionRepository) CreatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error) {
+	args := m.Called(ctx, promotion)
+	return args.Get(0).(models.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) UpdatePromotion(ctx context.Context, promotion models.Promotion) (models.Promotion, error) {
+	args := m.Called(ctx, promotion)
+	return args.Get(0).(models.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) DeletePromotion(ctx context.Context, promotionID string) error {
+	args := m.Called(ctx, promotionID)
+	return args.Error(0)
+}
+
+func (m *MockPromotionRepository) GetPromotion(ctx context.Context, promotionID string) (models.Promotion, error) {
+	args := m.Called(ctx, promotionID)
+	return args.Get(0).(models.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) ListPromotions(ctx context.Context) ([]models.Promotion, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) ListOffers(ctx context.Context, at time.Time) ([]models.Offer, error) {
+	args := m.Called(ctx, at)
+	return args.Get(0).([]models.Offer), args.Error(1)
+}
+
+func TestPromotionService_CreatePromotion(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 11, 27, 0, 0, 0, 0, time.UTC)
+	end := start.Add(72 * time.Hour)
+
+	t.Run("Defaults To Matching All Conditions", func(t *testing.T) {
+		mockRepo := new(MockPromotionRepository)
+		svc := NewPromotionService(mockRepo)
+		rule := models.PromotionRule{Conditions: []models.PromotionCondition{
+			{Attribute: models.RuleCategoryID, Operator: models.OpIn, Values: []string{"audio", "laptops"}},
+			{Attribute: models.RulePrice, Operator: models.OpGreaterOrEqual, Value: 10000},
+		}}
+		mockRepo.On("CreatePromotion", ctx, mock.MatchedBy(func(p models.Promotion) bool {
+			return p.ID == "black-friday" && p.Name == "Black Friday" && p.Rule.Match == models.MatchAll
+		})).Return(models.Promotion{ID: "black-friday"}, nil).Once()
+
+		_, err := svc.CreatePromotion(ctx, models.Promotion{
+			ID: " black-friday ", Name: "Black Friday", Rule: rule,
+			DiscountType: models.DiscountPercent, DiscountValue: 20, StartsAt: &start, EndsAt: &end,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rejects Invalid Promotions", func(t *testing.T) {
+		mockRepo := new(MockPromotionRepository)
+		svc := NewPromotionService(mockRepo)
+		valid := models.Promotion{ID: "p", Name: "P", DiscountType: models.DiscountPercent, DiscountValue: 10}
+
+		invalid := []func(p *models.Promotion){
+			func(p *models.Promotion) { p.Name = " " },
+			func(p *models.Promotion) { p.DiscountValue = 101 },
+			func(p *models.Promotion) { p.DiscountType = models.DiscountFixed },
+			func(p *models.Promotion) { p.DiscountType = "BOGO" },
+			func(p *models.Promotion) { p.Rule.Match = "SOME" },
+			func(p *models.Promotion) { p.StartsAt, p.EndsAt = &end, &start },
+			func(p *models.Promotion) {
+				p.Rule.Conditions = []models.PromotionCondition{{Attribute: models.RuleQuantity, Operator: models.OpIn, Values: []string{"1"}}}
+			},
+		}
+		for _, change := range invalid {
+			promotion := valid
+			change(&promotion)
+			_, err := svc.CreatePromotion(ctx, promotion)
+			assert.ErrorIs(t, err, ErrInvalidPromotion)
+		}
+		mockRepo.AssertNotCalled(t, "CreatePromotion", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPromotion_Apply(t *testing.T) {
+	percent := models.Promotion{DiscountType: models.DiscountPercent, DiscountValue: 15}
+	fixed := models.Promotion{DiscountType: models.DiscountFixed, DiscountValue: 2500, DiscountCurrency: "USD"}
+
+	// 15% of 1999 is 299.85 cents, rounded to 300
+	assert.Equal(t, usd(1699), percent.Apply(usd(1999)))
+	assert.Equal(t, usd(0), fixed.Apply(usd(1999)), "never below zero")
+	assert.Equal(t, usd(27450), fixed.Apply(usd(29950)))
+	eur := models.Money{MinorUnits: 29950, Currency: "EUR"}
+	assert.Equal(t, eur, fixed.Apply(eur), "other currencies are not discounted")
+}
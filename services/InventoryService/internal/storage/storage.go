@@ -0,0 +1,23 @@
+// Package storage keeps binary objects, such as product images, behind one interface so the
+// service runs against S3-compatible object storage in the cluster and a local directory
+// in development.
+package storage
+
+import (
+	"context"
+	"strings"
+)
+
+type Storage interface {
+	// Put stores body under key, replacing any object already there.
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	// Delete removes the object under key. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL is where clients fetch the object under key.
+	URL(key string) string
+}
+
+// joinURL appends key to a base URL with exactly one slash between them.
+func joinURL(base string, key string) string {
+	return strings.TrimRight(base, "/") + "/" + strings.TrimLeft(key, "/")
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"inventory-service/internal/service"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CatalogImporter periodically runs queued catalog imports. Instances claim jobs through
+// the database, so every replica can run one without importing a file twice.
+type CatalogImporter struct {
+	svc      service.CatalogService
+	interval time.Duration
+	tracer   trace.Tracer
+}
+
+func NewCatalogImporter(svc service.CatalogService, interval time.Duration) *CatalogImporter {
+	return &CatalogImporter{
+		svc:      svc,
+		interval: interval,
+		tracer:   otel.Tracer("CatalogImporter"),
+	}
+}
+
+// Run works through the queue on every tick until ctx is cancelled.
+func (w *CatalogImporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil && w.runNext(ctx) {
+			}
+		}
+	}
+}
+
+// runNext runs one job in its own root span and reports whether the queue may hold more.
+func (w *CatalogImporter) runNext(ctx context.Context) bool {
+	ctx, span := w.tracer.Start(ctx, "RunCatalogImport", trace.WithNewRoot())
+	defer span.End()
+
+	ran, err := w.svc.RunNextImport(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "Failed to run catalog import", "error", err)
+		return false
+	}
+	return ran
+}
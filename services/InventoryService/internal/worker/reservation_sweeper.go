@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"inventory-service/internal/service"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// sweepBatchSize caps how many expired orders are released per tick.
+const sweepBatchSize = 100
+
+// ReservationSweeper periodically returns stock held by reservations that outlived their TTL,
+// e.g. when OrderService crashed before running its compensation step.
+type ReservationSweeper struct {
+	svc      service.InventoryService
+	interval time.Duration
+	tracer   trace.Tracer
+}
+
+func NewReservationSweeper(svc service.InventoryService, interval time.Duration) *ReservationSweeper {
+	return &ReservationSweeper{
+		svc:      svc,
+		interval: interval,
+		tracer:   otel.Tracer("ReservationSweeper"),
+	}
+}
+
+// Run sweeps on every tick until ctx is cancelled.
+func (w *ReservationSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.sweep(ctx)
+		}
+	}
+}
+
+func (w *ReservationSweeper) sweep(ctx context.Context) {
+	orderIDs, err := w.svc.ListExpiredReservations(ctx, sweepBatchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list expired reservations", "error", err)
+		return
+	}
+
+	for _, orderID := range orderIDs {
+		w.expire(ctx, orderID)
+	}
+}
+
+// expire releases one order in its own root span so auto-releases are easy to find in traces.
+// The span only carries a release reason when stock was actually returned: an order confirmed
+// or released since it was listed has nothing left to expire.
+func (w *ReservationSweeper) expire(ctx context.Context, orderID string) {
+	ctx, span := w.tracer.Start(ctx, "ExpireReservation",
+		trace.WithNewRoot(),
+		trace.WithAttributes(attribute.String("order_id", orderID)),
+	)
+	defer span.End()
+
+	released, err := w.svc.ExpireReservation(ctx, orderID)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	if len(released) > 0 {
+		span.SetAttributes(
+			attribute.String("release_reason", "expired"),
+			attribute.Int("released_lines", len(released)),
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"inventory-service/internal/models"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TestSweepKeepsConfirmedReservations runs a sweeper pass over a fulfilled order and an
+// abandoned one whose reservations have both expired. It needs a disposable Postgres, e.g.
+// INVENTORY_TEST_DSN="host=localhost user=admin password=password123 dbname=inventory_test port=5433 sslmode=disable"
+func TestSweepKeepsConfirmedReservations(t *testing.T) {
+	dsn := os.Getenv("INVENTORY_TEST_DSN")
+	if dsn == "" {
+		t.Skip("INVENTORY_TEST_DSN not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&models.ProductStock{}, &models.IdempotencyRecord{}, &models.Reservation{},
+		&models.BundleComponent{}, &models.ReservedComponent{}); err != nil {
+		t.Fatal(err)
+	}
+
+	const productID = "SWEEP-001"
+	paid, abandoned := "SWEEP-ORD-PAID", "SWEEP-ORD-ABANDONED"
+	orderIDs := []string{paid, abandoned}
+	db.Save(&models.ProductStock{ProductID: productID, Name: productID, Quantity: 10})
+	t.Cleanup(func() {
+		db.Where("order_id IN ?", orderIDs).Delete(&models.Reservation{})
+		db.Where("order_id IN ?", orderIDs).Delete(&models.IdempotencyRecord{})
+		db.Where("product_id = ?", productID).Delete(&models.ProductStock{})
+	})
+
+	svc := service.NewInventoryService(repository.NewPostgresRepository(db))
+	ctx := context.Background()
+	for _, orderID := range orderIDs {
+		if success, msg, err := svc.Reserve(ctx, orderID, productID, 3, time.Millisecond); err != nil || !success {
+			t.Fatalf("reserve %s: %s %v", orderID, msg, err)
+		}
+	}
+	if success, msg, err := svc.ConfirmReservation(ctx, paid); err != nil || !success {
+		t.Fatalf("confirm %s: %s %v", paid, msg, err)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	NewReservationSweeper(svc, time.Minute).sweep(ctx)
+
+	// The paid order keeps its 3 units; only the abandoned order's 3 come back
+	var stock models.ProductStock
+	if err := db.First(&stock, "product_id = ?", productID).Error; err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int32(7), stock.Quantity)
+	assert.Equal(t, int32(0), stock.Reserved)
+
+	summary, err := svc.GetReservation(ctx, paid)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReservationConfirmed, summary.Status)
+
+	summary, err = svc.GetReservation(ctx, abandoned)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReservationReleased, summary.Status)
+}
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/internal/service"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// purgeBatchSize caps how many orders are deleted per transaction.
+	purgeBatchSize = 500
+	// purgeMaxBatches caps how many batches one tick may run, so a large backlog is
+	// worked off over several ticks instead of holding the database for one long run.
+	purgeMaxBatches = 20
+)
+
+// RetentionPurger periodically deletes settled reservations and their idempotency records
+// once they fall outside the service's retention window.
+type RetentionPurger struct {
+	svc      service.InventoryService
+	interval time.Duration
+	tracer   trace.Tracer
+	records  metric.Int64Counter
+	lines    metric.Int64Counter
+}
+
+// NewRetentionPurger creates the purger and its counters on the global meter provider.
+func NewRetentionPurger(svc service.InventoryService, interval time.Duration) (*RetentionPurger, error) {
+	meter := otel.Meter("RetentionPurger")
+	records, err := meter.Int64Counter("inventory.purge.idempotency_records",
+		metric.WithDescription("Idempotency records deleted by the retention purge"),
+		metric.WithUnit("{record}"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create purged records counter: %w", err)
+	}
+	lines, err := meter.Int64Counter("inventory.purge.reservation_lines",
+		metric.WithDescription("Reservation ledger lines deleted by the retention purge"),
+		metric.WithUnit("{line}"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create purged lines counter: %w", err)
+	}
+
+	return &RetentionPurger{
+		svc:      svc,
+		interval: interval,
+		tracer:   otel.Tracer("RetentionPurger"),
+		records:  records,
+		lines:    lines,
+	}, nil
+}
+
+// Run purges on every tick until ctx is cancelled.
+func (w *RetentionPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.purge(ctx)
+		}
+	}
+}
+
+func (w *RetentionPurger) purge(ctx context.Context) {
+	ctx, span := w.tracer.Start(ctx, "PurgeCompletedReservations", trace.WithNewRoot())
+	defer span.End()
+
+	var totalRecords, totalLines int64
+	for i := 0; i < purgeMaxBatches && ctx.Err() == nil; i++ {
+		records, lines, err := w.svc.PurgeCompletedReservations(ctx, purgeBatchSize)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			break
+		}
+		w.records.Add(ctx, records)
+		w.lines.Add(ctx, lines)
+		totalRecords += records
+		totalLines += lines
+
+		if records < purgeBatchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int64("purged_records", totalRecords),
+		attribute.Int64("purged_lines", totalLines),
+	)
+	if totalRecords > 0 {
+		slog.InfoContext(ctx, "Retention purge finished", "records", totalRecords, "lines", totalLines)
+	}
+}
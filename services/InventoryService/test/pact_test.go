@@ -9,6 +9,7 @@ import (
 
 	"inventory-service/internal/api/rest"
 	invmodels "inventory-service/internal/models"
+	"inventory-service/internal/service"
 	"github.com/gin-gonic/gin"
 	pactmodels "github.com/pact-foundation/pact-go/v2/models"
 	"github.com/pact-foundation/pact-go/v2/provider"
@@ -20,8 +21,8 @@ type MockInventoryService struct {
 	mock.Mock
 }
 
-func (m *MockInventoryService) Reserve(ctx context.Context, orderID string, productID string, quantity int32) (bool, string, error) {
-	args := m.Called(ctx, orderID, productID, quantity)
+func (m *MockInventoryService) Reserve(ctx context.Context, orderID string, productID string, quantity int32, ttl time.Duration) (bool, string, error) {
+	args := m.Called(ctx, orderID, productID, quantity, ttl)
 	return args.Bool(0), args.String(1), args.Error(2)
 }
 
@@ -30,6 +31,46 @@ func (m *MockInventoryService) Release(ctx context.Context, orderID string, prod
 	return args.Bool(0), args.String(1), args.Error(2)
 }
 
+func (m *MockInventoryService) BatchReserve(ctx context.Context, orderID string, items []invmodels.BatchItem, ttl time.Duration, allowPartial bool) (bool, string, []invmodels.BatchItemResult, error) {
+	args := m.Called(ctx, orderID, items, ttl, allowPartial)
+	return args.Bool(0), args.String(1), args.Get(2).([]invmodels.BatchItemResult), args.Error(3)
+}
+
+func (m *MockInventoryService) BatchRelease(ctx context.Context, orderID string, items []invmodels.BatchItem) (bool, string, []invmodels.BatchItemResult, error) {
+	args := m.Called(ctx, orderID, items)
+	return args.Bool(0), args.String(1), args.Get(2).([]invmodels.BatchItemResult), args.Error(3)
+}
+
+func (m *MockInventoryService) ConfirmReservation(ctx context.Context, orderID string) (bool, string, error) {
+	args := m.Called(ctx, orderID)
+	return args.Bool(0), args.String(1), args.Error(2)
+}
+
+func (m *MockInventoryService) CancelReservation(ctx context.Context, orderID string) (bool, string, error) {
+	args := m.Called(ctx, orderID)
+	return args.Bool(0), args.String(1), args.Error(2)
+}
+
+func (m *MockInventoryService) GetReservation(ctx context.Context, orderID string) (invmodels.ReservationSummary, error) {
+	args := m.Called(ctx, orderID)
+	return args.Get(0).(invmodels.ReservationSummary), args.Error(1)
+}
+
+func (m *MockInventoryService) ListExpiredReservations(ctx context.Context, limit int) ([]string, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockInventoryService) ExpireReservation(ctx context.Context, orderID string) ([]invmodels.BatchItemResult, error) {
+	args := m.Called(ctx, orderID)
+	return args.Get(0).([]invmodels.BatchItemResult), args.Error(1)
+}
+
+func (m *MockInventoryService) PurgeCompletedReservations(ctx context.Context, limit int) (int64, int64, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).(int64), args.Get(1).(int64), args.Error(2)
+}
+
 func (m *MockInventoryService) GetStock(ctx context.Context, productID string) (int32, error) {
 	args := m.Called(ctx, productID)
 	return int32(args.Int(0)), args.Error(1)
@@ -40,31 +81,76 @@ func (m *MockInventoryService) GetProduct(ctx context.Context, productID string)
 	return args.Get(0).(invmodels.ProductStock), args.Error(1)
 }
 
-func (m *MockInventoryService) ListProducts(ctx context.Context) ([]invmodels.ProductStock, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]invmodels.ProductStock), args.Error(1)
+func (m *MockInventoryService) ListProducts(ctx context.Context, query invmodels.ProductQuery) (invmodels.ProductPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(invmodels.ProductPage), args.Error(1)
+}
+
+func (m *MockInventoryService) SearchProducts(ctx context.Context, query invmodels.SearchQuery) (invmodels.SearchPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(invmodels.SearchPage), args.Error(1)
 }
 
-func (m *MockInventoryService) CreateProduct(ctx context.Context, productID string, name string, price float64, quantity int32) (bool, string, error) {
-	args := m.Called(ctx, productID, name, price, quantity)
+func (m *MockInventoryService) CreateProduct(ctx context.Context, productID string, name string, description string, price invmodels.Money, quantity int32) (bool, string, error) {
+	args := m.Called(ctx, productID, name, description, price, quantity)
 	return args.Bool(0), args.String(1), args.Error(2)
 }
 
-func (m *MockInventoryService) UpdateProduct(ctx context.Context, productID string, name string, price float64, quantity int32) (bool, string, error) {
-	args := m.Called(ctx, productID, name, price, quantity)
+func (m *MockInventoryService) UpdateProduct(ctx context.Context, productID string, version int64, anyVersion bool, name string, description string, price invmodels.Money) (bool, string, error) {
+	args := m.Called(ctx, productID, version, anyVersion, name, description, price)
 	return args.Bool(0), args.String(1), args.Error(2)
 }
 
+func (m *MockInventoryService) PatchProduct(ctx context.Context, productID string, patch invmodels.ProductPatch) (invmodels.ProductStock, error) {
+	args := m.Called(ctx, productID, patch)
+	return args.Get(0).(invmodels.ProductStock), args.Error(1)
+}
+
+func (m *MockInventoryService) AdjustStock(ctx context.Context, productID string, delta int32, reason string) (invmodels.ProductStock, error) {
+	args := m.Called(ctx, productID, delta, reason)
+	return args.Get(0).(invmodels.ProductStock), args.Error(1)
+}
+
 func (m *MockInventoryService) DeleteProduct(ctx context.Context, productID string) (bool, string, error) {
 	args := m.Called(ctx, productID)
 	return args.Bool(0), args.String(1), args.Error(2)
 }
 
+func (m *MockInventoryService) CreateVariant(ctx context.Context, parentID string, productID string, name string, options invmodels.OptionValues, priceOverride *int64, quantity int32) (invmodels.ProductStock, error) {
+	args := m.Called(ctx, parentID, productID, name, options, priceOverride, quantity)
+	return args.Get(0).(invmodels.ProductStock), args.Error(1)
+}
+
+func (m *MockInventoryService) UpdateVariant(ctx context.Context, productID string, options invmodels.OptionValues, priceOverride *int64) (invmodels.ProductStock, error) {
+	args := m.Called(ctx, productID, options, priceOverride)
+	return args.Get(0).(invmodels.ProductStock), args.Error(1)
+}
+
+func (m *MockInventoryService) ListVariants(ctx context.Context, parentID string) ([]invmodels.ProductStock, error) {
+	args := m.Called(ctx, parentID)
+	return args.Get(0).([]invmodels.ProductStock), args.Error(1)
+}
+
+func (m *MockInventoryService) RestoreProduct(ctx context.Context, productID string) (invmodels.ProductStock, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).(invmodels.ProductStock), args.Error(1)
+}
+
+func (m *MockInventoryService) PurgeProduct(ctx context.Context, productID string) error {
+	args := m.Called(ctx, productID)
+	return args.Error(0)
+}
+
 func (m *MockInventoryService) RestockItems(ctx context.Context, productID string, quantity int32) (bool, string, error) {
 	args := m.Called(ctx, productID, quantity)
 	return args.Bool(0), args.String(1), args.Error(2)
 }
 
+func (m *MockInventoryService) SetBundleComponents(ctx context.Context, bundleID string, components []invmodels.BundleComponent) (invmodels.ProductStock, error) {
+	args := m.Called(ctx, bundleID, components)
+	return args.Get(0).(invmodels.ProductStock), args.Error(1)
+}
+
 func TestInventoryPactProvider(t *testing.T) {
 	// 1. Setup Mock Service
 	mockSvc := new(MockInventoryService)
@@ -76,7 +162,9 @@ func TestInventoryPactProvider(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	r := gin.Default()
 	
-	handler := rest.NewInventoryHandler(mockSvc)
+	// No category or price-list interactions in the contract; without a currency or price
+	// list the pricing service passes catalog prices through untouched
+	handler := rest.NewInventoryHandler(mockSvc, nil, service.NewPricingService(nil), nil, nil, nil)
 	handler.SetupRoutes(r)
 	
 	// Start server on a random port
@@ -122,7 +210,7 @@ func TestInventoryPactProvider(t *testing.T) {
 			},
 			"Product PROD-001 has 100 units": func(setup bool, state pactmodels.ProviderState) (pactmodels.ProviderStateResponse, error) {
 				// This state is for reservation test
-				mockSvc.On("Reserve", mock.Anything, mock.Anything, "PROD-001", int32(5)).Return(true, "Stock reserved successfully", nil)
+				mockSvc.On("Reserve", mock.Anything, mock.Anything, "PROD-001", int32(5), time.Duration(0)).Return(true, "Stock reserved successfully", nil)
 				return nil, nil
 			},
 		},
@@ -20,6 +20,7 @@ public class OrderProcessingServiceTests
     private readonly Mock<ILogger<OrderProcessingService>> _loggerMock;
     private readonly Mock<Payments.V1.PaymentService.PaymentServiceClient> _paymentClientMock;
     private readonly Mock<Inventory.V1.InventoryService.InventoryServiceClient> _inventoryClientMock;
+    private readonly Mock<Loyalty.V1.LoyaltyService.LoyaltyServiceClient> _loyaltyClientMock;
     private readonly Mock<ISagaService> _sagaServiceMock;
     private readonly Mock<IIdempotencyService> _idempotencyServiceMock;
     private readonly Mock<IHttpClientFactory> _httpClientFactoryMock;
@@ -32,6 +33,7 @@ public class OrderProcessingServiceTests
         _loggerMock = new Mock<ILogger<OrderProcessingService>>();
         _paymentClientMock = new Mock<Payments.V1.PaymentService.PaymentServiceClient>();
         _inventoryClientMock = new Mock<Inventory.V1.InventoryService.InventoryServiceClient>();
+        _loyaltyClientMock = new Mock<Loyalty.V1.LoyaltyService.LoyaltyServiceClient>();
         _sagaServiceMock = new Mock<ISagaService>();
         _idempotencyServiceMock = new Mock<IIdempotencyService>();
         _httpClientFactoryMock = new Mock<IHttpClientFactory>();
@@ -48,6 +50,7 @@ public class OrderProcessingServiceTests
             _loggerMock.Object, 
             _paymentClientMock.Object, 
             _inventoryClientMock.Object,
+            _loyaltyClientMock.Object,
             _dbContext,
             _sagaServiceMock.Object,
             _idempotencyServiceMock.Object,
@@ -89,6 +92,9 @@ public class OrderProcessingServiceTests
             .Setup(c => c.ProcessPaymentAsync(It.IsAny<ProcessPaymentRequest>(), It.IsAny<Metadata>(), null, default))
             .Returns(mockPaymentCall);
 
+        SetupConfirmation(success: true);
+        SetupLoyaltyEarning();
+
         var context = new TestServerCallContext(new Metadata());
 
         // Act
@@ -99,6 +105,104 @@ public class OrderProcessingServiceTests
         var savedOrder = await _dbContext.Orders.FirstOrDefaultAsync();
         savedOrder.Should().NotBeNull();
         savedOrder!.Status.Should().Be("COMPLETED");
+
+        // An unconfirmed reservation would expire and hand the sold stock back
+        _inventoryClientMock.Verify(c => c.ConfirmReservationAsync(
+            It.Is<ConfirmReservationRequest>(r => r.OrderId == savedOrder.Id.ToString()), It.IsAny<Metadata>(), null, default), Times.Once);
+        _inventoryClientMock.Verify(c => c.CancelReservationAsync(It.IsAny<CancelReservationRequest>(), It.IsAny<Metadata>(), null, default), Times.Never);
+    }
+
+    [Fact]
+    public async Task CreateOrder_WhenPaymentFails_CancelsReservation()
+    {
+        // Arrange
+        SetupReservation();
+        SetupPayment(success: false);
+        SetupCancellation();
+
+        // Act
+        var result = await _service.CreateOrder(NewRequest(), new TestServerCallContext(new Metadata()));
+
+        // Assert
+        result.Status.Should().Be("FAILED");
+        var savedOrder = await _dbContext.Orders.SingleAsync();
+        savedOrder.Status.Should().Be("PAYMENT_FAILED_SAGA_REVERSED");
+
+        _inventoryClientMock.Verify(c => c.CancelReservationAsync(
+            It.Is<CancelReservationRequest>(r => r.OrderId == savedOrder.Id.ToString()), It.IsAny<Metadata>(), null, default), Times.Once);
+        _inventoryClientMock.Verify(c => c.ConfirmReservationAsync(It.IsAny<ConfirmReservationRequest>(), It.IsAny<Metadata>(), null, default), Times.Never);
+        _inventoryClientMock.Verify(c => c.BatchReleaseStockAsync(It.IsAny<BatchReleaseStockRequest>(), It.IsAny<Metadata>(), null, default), Times.Never);
+    }
+
+    [Fact]
+    public async Task CreateOrder_WhenConfirmationFails_RefundsPaymentAndCancelsReservation()
+    {
+        // Arrange: the reservation expired while the payment was in flight
+        SetupReservation();
+        SetupPayment(success: true);
+        SetupConfirmation(success: false);
+        SetupCancellation();
+        _paymentClientMock
+            .Setup(c => c.RefundPaymentAsync(It.IsAny<RefundPaymentRequest>(), It.IsAny<Metadata>(), null, default))
+            .Returns(Call(new RefundPaymentResponse { PaymentId = "pay-789", Success = true }));
+
+        // Act
+        var result = await _service.CreateOrder(NewRequest(), new TestServerCallContext(new Metadata()));
+
+        // Assert
+        result.Status.Should().Be("FAILED");
+        var savedOrder = await _dbContext.Orders.SingleAsync();
+        savedOrder.Status.Should().Be("INVENTORY_CONFIRMATION_FAILED_SAGA_REVERSED");
+
+        _paymentClientMock.Verify(c => c.RefundPaymentAsync(
+            It.Is<RefundPaymentRequest>(r => r.PaymentId == "pay-789"), It.IsAny<Metadata>(), null, default), Times.Once);
+        _inventoryClientMock.Verify(c => c.CancelReservationAsync(It.IsAny<CancelReservationRequest>(), It.IsAny<Metadata>(), null, default), Times.Once);
+        _loyaltyClientMock.Verify(c => c.AddLoyaltyPointsAsync(It.IsAny<Loyalty.V1.AddLoyaltyPointsRequest>(), It.IsAny<Metadata>(), null, default), Times.Never);
+    }
+
+    private static CreateOrderRequest NewRequest()
+    {
+        var request = new CreateOrderRequest { UserId = "user-123" };
+        request.Items.Add(new Orders.V1.OrderItem { ProductId = "prod-456", Quantity = 1, UnitPrice = 100.0 });
+        return request;
+    }
+
+    private static AsyncUnaryCall<TResponse> Call<TResponse>(TResponse response) =>
+        TestCalls.AsyncUnaryCall(response, Task.FromResult(new Metadata()), () => Status.DefaultSuccess, () => new Metadata(), () => { });
+
+    private void SetupReservation()
+    {
+        _inventoryClientMock
+            .Setup(c => c.BatchReserveStockAsync(It.IsAny<BatchReserveStockRequest>(), It.IsAny<Metadata>(), null, default))
+            .Returns(Call(new BatchReserveStockResponse { Success = true, Message = "Success" }));
+    }
+
+    private void SetupPayment(bool success)
+    {
+        _paymentClientMock
+            .Setup(c => c.ProcessPaymentAsync(It.IsAny<ProcessPaymentRequest>(), It.IsAny<Metadata>(), null, default))
+            .Returns(Call(new ProcessPaymentResponse { PaymentId = "pay-789", Success = success, StatusMessage = success ? "Success" : "Card declined" }));
+    }
+
+    private void SetupConfirmation(bool success)
+    {
+        _inventoryClientMock
+            .Setup(c => c.ConfirmReservationAsync(It.IsAny<ConfirmReservationRequest>(), It.IsAny<Metadata>(), null, default))
+            .Returns(Call(new ConfirmReservationResponse { Success = success, Message = success ? "Reservation confirmed successfully" : "reservation already released" }));
+    }
+
+    private void SetupCancellation()
+    {
+        _inventoryClientMock
+            .Setup(c => c.CancelReservationAsync(It.IsAny<CancelReservationRequest>(), It.IsAny<Metadata>(), null, default))
+            .Returns(Call(new CancelReservationResponse { Success = true, Message = "Reservation cancelled successfully" }));
+    }
+
+    private void SetupLoyaltyEarning()
+    {
+        _loyaltyClientMock
+            .Setup(c => c.AddLoyaltyPointsAsync(It.IsAny<Loyalty.V1.AddLoyaltyPointsRequest>(), It.IsAny<Metadata>(), null, default))
+            .Returns(Call(new Loyalty.V1.AddLoyaltyPointsResponse { Success = true }));
     }
 }
 
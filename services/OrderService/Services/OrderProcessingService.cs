@@ -176,7 +176,7 @@ public class OrderProcessingService : Orders.V1.OrderService.OrderServiceBase
                     await _sagaService.LogStepAsync(order.Id, "LoyaltyDeductionFailed", "Failed", new { loyaltyResponse.Message });
 
                     // COMPENSATION FOR STEP 1
-                    await ReleaseStockAsync(order, metadata);
+                    await CancelReservationAsync(order, metadata);
                     
                     order.Status = "LOYALTY_DEDUCTION_FAILED";
                     await _dbContext.SaveChangesAsync();
@@ -208,37 +208,16 @@ public class OrderProcessingService : Orders.V1.OrderService.OrderServiceBase
                     new { paymentResponse.StatusMessage });
                 
                 // COMPENSATION FOR STEP 2 (LOYALTY REFUND)
-                if (order.LoyaltyPointsSpent > 0)
-                {
-                    _logger.LogInformation("TRIGGERING COMPENSATION: Refunding points for Order {OrderId}", order.Id);
-                    await _sagaService.LogStepAsync(order.Id, "LoyaltyRefundRequested", "Pending");
-                    await _loyaltyClient.RefundLoyaltyPointsAsync(new Loyalty.V1.RefundLoyaltyPointsRequest
-                    {
-                        UserId = order.UserId,
-                        Points = order.LoyaltyPointsSpent,
-                        OrderId = order.Id.ToString()
-                    }, metadata);
-                    await _sagaService.LogStepAsync(order.Id, "LoyaltyRefundCompleted", "Completed");
-                }
+                await RefundLoyaltyPointsAsync(order, metadata);
 
                 // COMPENSATION FOR STEP 1 (STOCK RELEASE)
-                await ReleaseStockAsync(order, metadata);
+                await CancelReservationAsync(order, metadata);
 
                 await _sagaService.LogStepAsync(order.Id, "SagaCompensated", "Completed");
 
                 order.Status = "PAYMENT_FAILED_SAGA_REVERSED";
                 await _dbContext.SaveChangesAsync();
-
-                var response = new CreateOrderResponse
-                {
-                    OrderId = order.Id.ToString(),
-                    Status = "FAILED"
-                };
-
-                if (!string.IsNullOrEmpty(idempotencyKey))
-                    await _idempotencyService.SaveResponseAsync(idempotencyKey, 200, response);
-
-                return response;
+                return await FailedResponse(order, idempotencyKey);
             }
 
             await _sagaService.LogStepAsync(order.Id, "PaymentCompleted", "Completed",
@@ -246,9 +225,45 @@ public class OrderProcessingService : Orders.V1.OrderService.OrderServiceBase
             _logger.LogInformation("SAGA STEP 3 SUCCESS: Payment processed for Order {OrderId}", order.Id);
             order.PaymentId = paymentResponse.PaymentId;
 
-            // SAGA STEP 4: ADD EARNED POINTS (10% OF ORDER AMOUNT)
+            // SAGA STEP 4: CONFIRM INVENTORY RESERVATION
+            // Until it is confirmed the reservation expires and InventoryService returns the stock
+            _logger.LogInformation("SAGA STEP 4: Confirming stock reservation for Order {OrderId}", order.Id);
+            await _sagaService.LogStepAsync(order.Id, "InventoryConfirmationRequested", "Pending");
+
+            var confirmResponse = await _inventoryClient.ConfirmReservationAsync(new ConfirmReservationRequest
+            {
+                OrderId = order.Id.ToString()
+            }, metadata);
+
+            if (!confirmResponse.Success)
+            {
+                // The reservation expired while the payment was in flight, so the stock may be gone
+                _logger.LogWarning("SAGA STEP 4 FAILED: {Message}", confirmResponse.Message);
+                await _sagaService.LogStepAsync(order.Id, "InventoryConfirmationFailed", "Failed",
+                    new { confirmResponse.Message });
+
+                // COMPENSATION FOR STEP 3 (PAYMENT REFUND)
+                await RefundPaymentAsync(order, metadata);
+
+                // COMPENSATION FOR STEP 2 (LOYALTY REFUND)
+                await RefundLoyaltyPointsAsync(order, metadata);
+
+                // COMPENSATION FOR STEP 1 (STOCK RELEASE)
+                await CancelReservationAsync(order, metadata);
+
+                await _sagaService.LogStepAsync(order.Id, "SagaCompensated", "Completed");
+
+                order.Status = "INVENTORY_CONFIRMATION_FAILED_SAGA_REVERSED";
+                await _dbContext.SaveChangesAsync();
+                return await FailedResponse(order, idempotencyKey);
+            }
+
+            await _sagaService.LogStepAsync(order.Id, "InventoryConfirmationCompleted", "Completed");
+            _logger.LogInformation("SAGA STEP 4 SUCCESS: Stock reservation confirmed for Order {OrderId}", order.Id);
+
+            // SAGA STEP 5: ADD EARNED POINTS (10% OF ORDER AMOUNT)
             order.LoyaltyPointsEarned = (int)(order.Amount * 10);
-            _logger.LogInformation("SAGA STEP 4: Adding {Points} earned points for Order {OrderId}", order.LoyaltyPointsEarned, order.Id);
+            _logger.LogInformation("SAGA STEP 5: Adding {Points} earned points for Order {OrderId}", order.LoyaltyPointsEarned, order.Id);
             await _sagaService.LogStepAsync(order.Id, "LoyaltyEarningRequested", "Pending", new { order.LoyaltyPointsEarned });
             
             await _loyaltyClient.AddLoyaltyPointsAsync(new Loyalty.V1.AddLoyaltyPointsRequest
@@ -304,22 +319,63 @@ public class OrderProcessingService : Orders.V1.OrderService.OrderServiceBase
         }
     }
 
-    private async Task ReleaseStockAsync(Order order, Metadata metadata)
+    private async Task CancelReservationAsync(Order order, Metadata metadata)
     {
-        _logger.LogInformation("TRIGGERING COMPENSATION: Releasing batch stock for Order {OrderId}", order.Id);
+        _logger.LogInformation("TRIGGERING COMPENSATION: Cancelling stock reservation for Order {OrderId}", order.Id);
         await _sagaService.LogStepAsync(order.Id, "StockReleaseRequested", "Pending");
 
-        var releaseRequest = new BatchReleaseStockRequest { OrderId = order.Id.ToString() };
-        releaseRequest.Items.AddRange(order.Items.Select(i => new BatchItem 
-        { 
-            ProductId = i.ProductId, 
-            Quantity = i.Quantity 
-        }));
+        // Returns whatever the order still holds; a reservation that already expired is a no-op
+        var cancelResponse = await _inventoryClient.CancelReservationAsync(new CancelReservationRequest
+        {
+            OrderId = order.Id.ToString()
+        }, metadata);
+
+        if (!cancelResponse.Success)
+        {
+            _logger.LogWarning("Stock reservation for Order {OrderId} was not cancelled: {Message}", order.Id, cancelResponse.Message);
+            await _sagaService.LogStepAsync(order.Id, "StockReleaseFailed", "Failed", new { cancelResponse.Message });
+            return;
+        }
 
-        await _inventoryClient.BatchReleaseStockAsync(releaseRequest, metadata);
         await _sagaService.LogStepAsync(order.Id, "StockReleaseCompleted", "Completed");
     }
 
+    private async Task RefundLoyaltyPointsAsync(Order order, Metadata metadata)
+    {
+        if (order.LoyaltyPointsSpent <= 0) return;
+
+        _logger.LogInformation("TRIGGERING COMPENSATION: Refunding points for Order {OrderId}", order.Id);
+        await _sagaService.LogStepAsync(order.Id, "LoyaltyRefundRequested", "Pending");
+        await _loyaltyClient.RefundLoyaltyPointsAsync(new Loyalty.V1.RefundLoyaltyPointsRequest
+        {
+            UserId = order.UserId,
+            Points = order.LoyaltyPointsSpent,
+            OrderId = order.Id.ToString()
+        }, metadata);
+        await _sagaService.LogStepAsync(order.Id, "LoyaltyRefundCompleted", "Completed");
+    }
+
+    private async Task RefundPaymentAsync(Order order, Metadata metadata)
+    {
+        _logger.LogInformation("TRIGGERING COMPENSATION: Refunding payment {PaymentId} for Order {OrderId}", order.PaymentId, order.Id);
+        await _sagaService.LogStepAsync(order.Id, "PaymentRefundRequested", "Pending", new { order.PaymentId });
+
+        var refundResponse = await _paymentClient.RefundPaymentAsync(new RefundPaymentRequest
+        {
+            PaymentId = order.PaymentId ?? string.Empty,
+            Reason = "Stock reservation could not be confirmed"
+        }, metadata);
+
+        if (!refundResponse.Success)
+        {
+            _logger.LogError("Refund of payment {PaymentId} for Order {OrderId} failed: {Message}", order.PaymentId, order.Id, refundResponse.StatusMessage);
+            await _sagaService.LogStepAsync(order.Id, "PaymentRefundFailed", "Failed", new { refundResponse.StatusMessage });
+            return;
+        }
+
+        await _sagaService.LogStepAsync(order.Id, "PaymentRefundCompleted", "Completed");
+    }
+
     private async Task TriggerPdfGeneration(Order order, string correlationId)
     {
         try
@@ -365,6 +421,20 @@ public class OrderProcessingService : Orders.V1.OrderService.OrderServiceBase
         }
     }
 
+    private async Task<CreateOrderResponse> FailedResponse(Order order, string? idempotencyKey)
+    {
+        var response = new CreateOrderResponse
+        {
+            OrderId = order.Id.ToString(),
+            Status = "FAILED"
+        };
+
+        if (!string.IsNullOrEmpty(idempotencyKey))
+            await _idempotencyService.SaveResponseAsync(idempotencyKey, 200, response);
+
+        return response;
+    }
+
     private async Task<CreateOrderResponse> ErrorResponse(Order order, string message, string? idempotencyKey = null)
     {
         var response = new CreateOrderResponse